@@ -5,7 +5,9 @@ package v1
 
 import (
 	"fmt"
+	"log"
 
+	"github.com/spf13/pflag"
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
 	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
@@ -23,6 +25,9 @@ type createAPISubcommand struct {
 	workloadConfigPath string
 	cliRootCommandName string
 	workload           workloadv1.WorkloadAPIBuilder
+
+	rbacStrict     bool
+	rbacReportPath string
 }
 
 var _ plugin.CreateAPISubcommand = &createAPISubcommand{}
@@ -35,6 +40,11 @@ func (p *createAPISubcommand) UpdateMetadata(cliMeta plugin.CLIMetadata, subcmdM
 `, cliMeta.CommandName)
 }
 
+func (p *createAPISubcommand) BindFlags(fs *pflag.FlagSet) {
+	fs.BoolVar(&p.rbacStrict, "rbac-strict", false, "fail when rbac rules grant wildcard or privilege escalating permissions")
+	fs.StringVar(&p.rbacReportPath, "rbac-report", "", "path to write a report of the rbac rules granted to the operator")
+}
+
 func (p *createAPISubcommand) InjectConfig(c config.Config) error {
 	p.config = c
 
@@ -70,6 +80,16 @@ func (p *createAPISubcommand) PreScaffold(machinery.Filesystem) error {
 		return fmt.Errorf("unable to validate config %s, %w", p.workloadConfigPath, err)
 	}
 
+	// ensure that a namespace-scoped operator does not manage cluster-scoped resources
+	if err := workloadv1.ValidateOperatorScope(workload); err != nil {
+		return fmt.Errorf("unable to validate operator scope for %s, %w", p.workloadConfigPath, err)
+	}
+
+	// analyze the rbac rules of the workload config
+	if err := p.analyzeRBAC(workload); err != nil {
+		return err
+	}
+
 	p.workload = workload
 
 	return nil
@@ -90,3 +110,27 @@ func (p *createAPISubcommand) Scaffold(fs machinery.Filesystem) error {
 
 	return nil
 }
+
+func (p *createAPISubcommand) analyzeRBAC(workload workloadv1.WorkloadAPIBuilder) error {
+	report := workloadv1.NewRBACReport(workload)
+
+	if p.rbacReportPath != "" {
+		if err := report.Write(p.rbacReportPath); err != nil {
+			return fmt.Errorf("unable to report rbac rules for %s, %w", p.workloadConfigPath, err)
+		}
+	}
+
+	if p.rbacStrict {
+		if err := report.Validate(); err != nil {
+			return fmt.Errorf("unable to validate rbac rules for %s, %w", p.workloadConfigPath, err)
+		}
+
+		return nil
+	}
+
+	for _, warning := range report.Warnings {
+		log.Printf("WARNING: %s", warning)
+	}
+
+	return nil
+}
@@ -23,6 +23,7 @@ import (
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/controller"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/controllers/phases"
 	controllersutils "github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/controllers/utils"
+	deletehook "github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/delete"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/dependencies"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/helpers"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/mutate"
@@ -81,6 +82,9 @@ func (s *apiScaffolder) Scaffold() error {
 	)
 
 	createFuncNames, initFuncNames := s.workload.GetFuncNames()
+	readyFuncNames := s.workload.GetReadyFuncNames()
+	resourceWaves := s.workload.GetResourceWaves()
+	recreatePolicies := s.workload.GetResourceRecreatePolicies()
 
 	// companion CLI
 	err = s.scaffoldCLI(scaffold)
@@ -97,10 +101,12 @@ func (s *apiScaffolder) Scaffold() error {
 				WireController: true,
 			},
 			&api.Types{
-				SpecFields:    s.workload.GetAPISpecFields(),
-				ClusterScoped: s.workload.IsClusterScoped(),
-				Dependencies:  s.workload.GetDependencies(),
-				IsStandalone:  s.workload.IsStandalone(),
+				SpecFields:        s.workload.GetAPISpecFields(),
+				ClusterScoped:     s.workload.IsClusterScoped(),
+				Dependencies:      s.workload.GetDependencies(),
+				ManagedComponents: s.workload.GetManagedComponents(),
+				IsStandalone:      s.workload.IsStandalone(),
+				IsCollection:      s.workload.IsCollection(),
 			},
 			&common.Components{
 				IsStandalone: s.workload.IsStandalone(),
@@ -108,10 +114,13 @@ func (s *apiScaffolder) Scaffold() error {
 			&common.Conditions{},
 			&common.Resources{},
 			&resources.Resources{
-				PackageName:     s.workload.GetPackageName(),
-				CreateFuncNames: createFuncNames,
-				InitFuncNames:   initFuncNames,
-				IsComponent:     s.workload.IsComponent(),
+				PackageName:      s.workload.GetPackageName(),
+				CreateFuncNames:  createFuncNames,
+				InitFuncNames:    initFuncNames,
+				ReadyFuncNames:   readyFuncNames,
+				ResourceWaves:    resourceWaves,
+				RecreatePolicies: recreatePolicies,
+				IsComponent:      s.workload.IsComponent(),
 			},
 			&resourcespkg.ResourceType{},
 			&resourcespkg.Resources{},
@@ -124,6 +133,21 @@ func (s *apiScaffolder) Scaffold() error {
 			&resourcespkg.JobType{},
 			&resourcespkg.SecretType{},
 			&resourcespkg.ServiceType{},
+			&resourcespkg.PersistentVolumeClaimType{},
+			&resourcespkg.IngressType{},
+			&resourcespkg.CronJobType{},
+			&resourcespkg.ReplicaSetType{},
+			&resourcespkg.PodType{},
+			&resourcespkg.APIServiceType{},
+			&resourcespkg.GenericType{},
+			&resourcespkg.MetricsType{},
+			&resourcespkg.PersistenceType{
+				Persistence: s.workload.GetPersistence(),
+			},
+			&resourcespkg.ConfigHashType{
+				Persistence: s.workload.GetPersistence(),
+			},
+			&resourcespkg.OwnershipType{},
 			&controller.Controller{
 				PackageName:       s.workload.GetPackageName(),
 				RBACRules:         s.workload.GetRBACRules(),
@@ -131,11 +155,15 @@ func (s *apiScaffolder) Scaffold() error {
 				HasChildResources: s.workload.HasChildResources(),
 				IsStandalone:      s.workload.IsStandalone(),
 				IsComponent:       s.workload.IsComponent(),
+				Children:          s.workload.GetChildren(),
+				NamespaceScoped:   s.workload.IsNamespaceScoped(),
+				Tracing:           s.workload.IsTracingEnabled(),
 			},
 			&controllersutils.Utils{
 				IsStandalone: s.workload.IsStandalone(),
 			},
 			&controllersutils.RateLimiter{},
+			&controllersutils.Watches{},
 			&phases.Types{},
 			&phases.Common{},
 			&phases.CreateResource{
@@ -144,13 +172,24 @@ func (s *apiScaffolder) Scaffold() error {
 			&phases.ResourcePersist{},
 			&phases.Dependencies{},
 			&phases.PreFlight{},
-			&phases.ResourceWait{},
+			&phases.ResourceWait{
+				NamespaceScoped: s.workload.IsNamespaceScoped(),
+			},
 			&phases.CheckReady{},
 			&phases.Complete{},
+			&phases.DeleteResource{},
+			&phases.PruneResource{},
+			&phases.ObserveResource{},
+			&phases.Metrics{},
+			&phases.Tracing{
+				Enabled: s.workload.IsTracingEnabled(),
+			},
+			&phases.Finalize{},
 			&helpers.Common{},
 			&helpers.Component{},
 			&dependencies.Component{},
 			&mutate.Component{},
+			&deletehook.Component{},
 			&wait.Component{},
 			&samples.CRDSample{
 				SpecFields: s.workload.GetAPISpecFields(),
@@ -167,10 +206,12 @@ func (s *apiScaffolder) Scaffold() error {
 				WireController: true,
 			},
 			&api.Types{
-				SpecFields:    s.workload.GetAPISpecFields(),
-				ClusterScoped: s.workload.IsClusterScoped(),
-				Dependencies:  s.workload.GetDependencies(),
-				IsStandalone:  s.workload.IsStandalone(),
+				SpecFields:        s.workload.GetAPISpecFields(),
+				ClusterScoped:     s.workload.IsClusterScoped(),
+				Dependencies:      s.workload.GetDependencies(),
+				ManagedComponents: s.workload.GetManagedComponents(),
+				IsStandalone:      s.workload.IsStandalone(),
+				IsCollection:      s.workload.IsCollection(),
 			},
 			&common.Components{
 				IsStandalone: s.workload.IsStandalone(),
@@ -178,10 +219,13 @@ func (s *apiScaffolder) Scaffold() error {
 			&common.Conditions{},
 			&common.Resources{},
 			&resources.Resources{
-				PackageName:     s.workload.GetPackageName(),
-				CreateFuncNames: createFuncNames,
-				InitFuncNames:   initFuncNames,
-				IsComponent:     s.workload.IsComponent(),
+				PackageName:      s.workload.GetPackageName(),
+				CreateFuncNames:  createFuncNames,
+				InitFuncNames:    initFuncNames,
+				ReadyFuncNames:   readyFuncNames,
+				ResourceWaves:    resourceWaves,
+				RecreatePolicies: recreatePolicies,
+				IsComponent:      s.workload.IsComponent(),
 			},
 			&resourcespkg.ResourceType{},
 			&resourcespkg.Resources{},
@@ -194,6 +238,21 @@ func (s *apiScaffolder) Scaffold() error {
 			&resourcespkg.JobType{},
 			&resourcespkg.SecretType{},
 			&resourcespkg.ServiceType{},
+			&resourcespkg.PersistentVolumeClaimType{},
+			&resourcespkg.IngressType{},
+			&resourcespkg.CronJobType{},
+			&resourcespkg.ReplicaSetType{},
+			&resourcespkg.PodType{},
+			&resourcespkg.APIServiceType{},
+			&resourcespkg.GenericType{},
+			&resourcespkg.MetricsType{},
+			&resourcespkg.PersistenceType{
+				Persistence: s.workload.GetPersistence(),
+			},
+			&resourcespkg.ConfigHashType{
+				Persistence: s.workload.GetPersistence(),
+			},
+			&resourcespkg.OwnershipType{},
 			&controller.Controller{
 				PackageName:       s.workload.GetPackageName(),
 				RBACRules:         s.workload.GetRBACRules(),
@@ -201,11 +260,15 @@ func (s *apiScaffolder) Scaffold() error {
 				HasChildResources: s.workload.HasChildResources(),
 				IsStandalone:      s.workload.IsStandalone(),
 				IsComponent:       s.workload.IsComponent(),
+				Children:          s.workload.GetChildren(),
+				NamespaceScoped:   s.workload.IsNamespaceScoped(),
+				Tracing:           s.workload.IsTracingEnabled(),
 			},
 			&controllersutils.Utils{
 				IsStandalone: s.workload.IsStandalone(),
 			},
 			&controllersutils.RateLimiter{},
+			&controllersutils.Watches{},
 			&phases.Types{},
 			&phases.Common{},
 			&phases.CreateResource{
@@ -214,13 +277,24 @@ func (s *apiScaffolder) Scaffold() error {
 			&phases.ResourcePersist{},
 			&phases.Dependencies{},
 			&phases.PreFlight{},
-			&phases.ResourceWait{},
+			&phases.ResourceWait{
+				NamespaceScoped: s.workload.IsNamespaceScoped(),
+			},
 			&phases.CheckReady{},
 			&phases.Complete{},
+			&phases.DeleteResource{},
+			&phases.PruneResource{},
+			&phases.ObserveResource{},
+			&phases.Metrics{},
+			&phases.Tracing{
+				Enabled: s.workload.IsTracingEnabled(),
+			},
+			&phases.Finalize{},
 			&helpers.Common{},
 			&helpers.Component{},
 			&dependencies.Component{},
 			&mutate.Component{},
+			&deletehook.Component{},
 			&wait.Component{},
 			&samples.CRDSample{
 				SpecFields: s.workload.GetAPISpecFields(),
@@ -231,7 +305,19 @@ func (s *apiScaffolder) Scaffold() error {
 			return fmt.Errorf("unable to scaffold collection workload, %w", err)
 		}
 
+		// nested collections are scaffolded as children of the collection in
+		// which they are nested, in the same manner as components
+		children := []workloadv1.WorkloadAPIBuilder{}
+
+		for _, collection := range s.workload.GetCollections() {
+			children = append(children, collection)
+		}
+
 		for _, component := range s.workload.GetComponents() {
+			children = append(children, component)
+		}
+
+		for _, component := range children {
 			componentScaffold := machinery.NewScaffold(s.fs,
 				machinery.WithConfig(s.config),
 				machinery.WithBoilerplate(string(boilerplate)),
@@ -243,6 +329,9 @@ func (s *apiScaffolder) Scaffold() error {
 			)
 
 			createFuncNames, initFuncNames := component.GetFuncNames()
+			readyFuncNames := component.GetReadyFuncNames()
+			resourceWaves := component.GetResourceWaves()
+			recreatePolicies := component.GetResourceRecreatePolicies()
 
 			err = componentScaffold.Execute(
 				&templates.MainUpdater{
@@ -250,18 +339,24 @@ func (s *apiScaffolder) Scaffold() error {
 					WireController: true,
 				},
 				&api.Types{
-					SpecFields:    component.Spec.APISpecFields,
-					ClusterScoped: component.IsClusterScoped(),
-					Dependencies:  component.GetDependencies(),
-					IsStandalone:  component.IsStandalone(),
+					SpecFields:        component.GetAPISpecFields(),
+					ClusterScoped:     component.IsClusterScoped(),
+					Dependencies:      component.GetDependencies(),
+					ManagedComponents: component.GetManagedComponents(),
+					IsStandalone:      component.IsStandalone(),
+					IsComponent:       true,
+					IsCollection:      component.IsCollection(),
 				},
 				&api.Group{},
 				&resources.Resources{
-					PackageName:     component.GetPackageName(),
-					CreateFuncNames: createFuncNames,
-					InitFuncNames:   initFuncNames,
-					IsComponent:     component.IsComponent(),
-					Collection:      s.workload.(*workloadv1.WorkloadCollection),
+					PackageName:      component.GetPackageName(),
+					CreateFuncNames:  createFuncNames,
+					InitFuncNames:    initFuncNames,
+					ReadyFuncNames:   readyFuncNames,
+					ResourceWaves:    resourceWaves,
+					RecreatePolicies: recreatePolicies,
+					IsComponent:      true,
+					Collection:       component.GetCollection(),
 				},
 				&controller.Controller{
 					PackageName:       component.GetPackageName(),
@@ -269,20 +364,25 @@ func (s *apiScaffolder) Scaffold() error {
 					OwnershipRules:    component.GetOwnershipRules(),
 					HasChildResources: component.HasChildResources(),
 					IsStandalone:      component.IsStandalone(),
-					IsComponent:       component.IsComponent(),
-					Collection:        s.workload.(*workloadv1.WorkloadCollection),
+					IsComponent:       true,
+					Collection:        component.GetCollection(),
+					Children:          component.GetChildren(),
+					Dependencies:      component.GetDependencies(),
+					NamespaceScoped:   component.IsNamespaceScoped(),
+					Tracing:           component.IsTracingEnabled(),
 				},
 				&dependencies.Component{},
 				&mutate.Component{},
+				&deletehook.Component{},
 				&helpers.Component{},
 				&wait.Component{},
 				&samples.CRDSample{
-					SpecFields: component.Spec.APISpecFields,
+					SpecFields: component.GetAPISpecFields(),
 				},
 				&crd.Kustomization{},
 			)
 			if err != nil {
-				return fmt.Errorf("unable to scaffold component workload %s, %w", component.Name, err)
+				return fmt.Errorf("unable to scaffold component workload %s, %w", component.GetName(), err)
 			}
 
 			// component child resource definition files
@@ -304,12 +404,28 @@ func (s *apiScaffolder) Scaffold() error {
 						SourceFile:    sourceFile,
 						PackageName:   component.GetPackageName(),
 						SpecFields:    component.GetAPISpecFields(),
-						IsComponent:   component.IsComponent(),
-						Collection:    s.workload.(*workloadv1.WorkloadCollection),
+						IsComponent:   true,
+						Collection:    component.GetCollection(),
 					},
 				)
 				if err != nil {
-					return fmt.Errorf("unable to scaffold component workload resource files for %s, %w", component.Name, err)
+					return fmt.Errorf("unable to scaffold component workload resource files for %s, %w", component.GetName(), err)
+				}
+			}
+
+			// custom resources of the components which are managed by a nested collection
+			if managedComponents := component.GetManagedComponents(); len(managedComponents) > 0 {
+				err = componentScaffold.Execute(
+					&resources.Components{
+						ClusterScoped:     component.IsClusterScoped(),
+						PackageName:       component.GetPackageName(),
+						ManagedComponents: managedComponents,
+						IsComponent:       true,
+						Collection:        component.GetCollection(),
+					},
+				)
+				if err != nil {
+					return fmt.Errorf("unable to scaffold managed components for %s, %w", component.GetName(), err)
 				}
 			}
 		}
@@ -338,10 +454,25 @@ func (s *apiScaffolder) Scaffold() error {
 		}
 	}
 
+	// custom resources of the components which are managed by the collection
+	if managedComponents := s.workload.GetManagedComponents(); len(managedComponents) > 0 {
+		err = scaffold.Execute(
+			&resources.Components{
+				ClusterScoped:     s.workload.IsClusterScoped(),
+				PackageName:       s.workload.GetPackageName(),
+				ManagedComponents: managedComponents,
+			},
+		)
+		if err != nil {
+			return fmt.Errorf("unable to scaffold managed components, %w", err)
+		}
+	}
+
 	return nil
 }
 
 // scaffoldCLI runs the specific logic to scaffold the companion CLI
+//
 //nolint:funlen,gocyclo //this will be refactored later
 func (s *apiScaffolder) scaffoldCLI(scaffold *machinery.Scaffold) error {
 	// do not scaffold the cli if the root command name is blank
@@ -349,23 +480,29 @@ func (s *apiScaffolder) scaffoldCLI(scaffold *machinery.Scaffold) error {
 		return nil
 	}
 
-	workloadCommands := make([]workloadv1.WorkloadAPIBuilder, len(s.workload.GetComponents())+1)
-	workloadCommands[0] = s.workload
+	workloadCommands := []workloadv1.WorkloadAPIBuilder{s.workload}
 
-	if len(s.workload.GetComponents()) > 0 {
-		for i, component := range s.workload.GetComponents() {
-			workloadCommands[i+1] = component
-		}
+	for _, collection := range s.workload.GetCollections() {
+		workloadCommands = append(workloadCommands, collection)
+	}
+
+	for _, component := range s.workload.GetComponents() {
+		workloadCommands = append(workloadCommands, component)
 	}
 
 	for _, workloadCommand := range workloadCommands {
+		// nested collections are treated as components of the collection
+		// in which they are nested
+		isRootCollection := workloadCommand.IsCollection() && workloadCommand.GetCollection() == nil
+
 		// build collection subcommands
-		if workloadCommand.IsCollection() {
+		if isRootCollection {
 			err := scaffold.Execute(
 				&cli.CmdInit{
 					RootCmd:        s.cliRootCommandName,
 					RootCmdVarName: workloadCommand.GetRootcommandVarName(),
 					SubCommands:    workloadCommand.GetSubcommands(),
+					Components:     workloadCommand.GetComponents(),
 				},
 				&cli.CmdGenerate{
 					RootCmd:        s.cliRootCommandName,
@@ -414,12 +551,16 @@ func (s *apiScaffolder) scaffoldCLI(scaffold *machinery.Scaffold) error {
 			SubCmdName:     workloadCommand.GetSubcommandName(),
 			SubCmdDescr:    workloadCommand.GetSubcommandDescr(),
 			IsComponent:    workloadCommand.IsComponent() || workloadCommand.IsCollection(),
-			IsCollection:   workloadCommand.IsCollection(),
+			IsCollection:   isRootCollection,
 		}
 
 		if workloadCommand.IsCollection() || workloadCommand.IsComponent() {
-			//nolint:forcetypeassert // this will be refactored later
-			generateSubCommand.Collection = s.workload.(*workloadv1.WorkloadCollection)
+			generateSubCommand.Collection = workloadCommand.GetCollection()
+			if isRootCollection {
+				//nolint:forcetypeassert // this will be refactored later
+				generateSubCommand.Collection = s.workload.(*workloadv1.WorkloadCollection)
+			}
+
 			generateSubCommand.SubCmdVarName = workloadCommand.GetSubcommandVarName()
 			generateSubCommand.SubCmdFileName = workloadCommand.GetSubcommandFileName()
 			generateSubCommand.ComponentResource = workloadCommand.GetComponentResource(
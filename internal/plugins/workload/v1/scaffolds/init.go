@@ -6,6 +6,7 @@ package scaffolds
 import (
 	"fmt"
 	"log"
+	"path"
 
 	"github.com/spf13/afero"
 	"sigs.k8s.io/kubebuilder/v3/pkg/config"
@@ -15,11 +16,17 @@ import (
 
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/cli"
+	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/config/rbac"
+	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/scope"
+	"github.com/vmware-tanzu-labs/operator-builder/internal/plugins/workload/v1/scaffolds/templates/int/tracing"
 	"github.com/vmware-tanzu-labs/operator-builder/internal/utils"
 	workloadv1 "github.com/vmware-tanzu-labs/operator-builder/internal/workload/v1"
 )
 
-const CobraVersion = "v1.1.3"
+const (
+	CobraVersion         = "v1.1.3"
+	OpenTelemetryVersion = "v1.0.1"
+)
 
 var _ plugins.Scaffolder = &initScaffolder{}
 
@@ -84,22 +91,47 @@ func (s *initScaffolder) Scaffold() error {
 	}
 
 	err = scaffold.Execute(
-		&templates.Main{},
+		&templates.Main{
+			NamespaceScoped: s.workload.IsNamespaceScoped(),
+			Tracing:         s.workload.IsTracingEnabled(),
+		},
 		&templates.GoMod{
 			ControllerRuntimeVersion: scaffolds.ControllerRuntimeVersion,
 			CobraVersion:             CobraVersion,
+			OpenTelemetryVersion:     OpenTelemetryVersion,
+			Tracing:                  s.workload.IsTracingEnabled(),
 		},
+		&scope.Scope{},
 		&templates.Dockerfile{},
 		&templates.Makefile{
 			RootCmd: s.workload.GetRootCmdName(),
 		},
 		&templates.Readme{
-			RootCmd: s.workload.GetRootCmdName(),
+			RootCmd:    s.workload.GetRootCmdName(),
+			Components: s.workload.GetComponents(),
 		},
 	)
 	if err != nil {
 		return fmt.Errorf("unable to scaffold initial configuration, %w", err)
 	}
 
+	// the tracing package, and with it the OpenTelemetry modules, is only generated
+	// when tracing is enabled
+	if s.workload.IsTracingEnabled() {
+		if err := scaffold.Execute(&tracing.Tracing{
+			ServiceName: path.Base(s.config.GetRepository()),
+		}); err != nil {
+			return fmt.Errorf("unable to scaffold tracing configuration, %w", err)
+		}
+	}
+
+	// a namespace-scoped operator binds the manager role, which is generated as a role
+	// rather than a cluster role, with a role binding
+	if s.workload.IsNamespaceScoped() {
+		if err := scaffold.Execute(&rbac.RoleBinding{}); err != nil {
+			return fmt.Errorf("unable to scaffold namespace-scoped rbac configuration, %w", err)
+		}
+	}
+
 	return nil
 }
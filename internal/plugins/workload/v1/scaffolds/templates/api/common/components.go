@@ -41,35 +41,58 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 )
 
 type Component interface {
 	GetComponentGVK() schema.GroupVersionKind
+	GetDeletionTimestamp() *metav1.Time
 	GetDependencies() []Component
 	GetDependencyStatus() bool
 	GetReadyStatus() bool
 	GetPhaseConditions() []PhaseCondition
+	GetConditions() []metav1.Condition
 	GetResources() []Resource
 
 	SetReadyStatus(bool)
 	SetDependencyStatus(bool)
 	SetPhaseCondition(PhaseCondition)
+	SetReadyCondition(metav1.ConditionStatus, string, string)
+	SetCondition(metav1.Condition)
+	RemoveCondition(string)
 	SetResource(Resource)
+	RemoveResource(Resource)
+}
+
+// CollectionComponent is a component which may reference the collection it belongs to.
+type CollectionComponent interface {
+	Component
+
+	GetCollectionRef() *CollectionReference
+}
+
+// DependentComponent is a component which may reference the custom resources of its
+// dependencies.
+type DependentComponent interface {
+	Component
+
+	GetDependencyRefs() []DependencyReference
 }
 
 type ComponentReconciler interface {
 	// attribute exporters and setters
 	GetClient() client.Client
+	GetCollection() client.Object
 	GetComponent() Component
 	GetContext() context.Context
 	GetController() controller.Controller
+	GetEventRecorder() record.EventRecorder
 	GetLogger() logr.Logger
 	GetScheme() *runtime.Scheme
 	GetResources() []ComponentResource
-	GetWatches() []client.Object
-	SetWatch(client.Object)
+	SetContext(context.Context)
 
 	// component and child resource methods
 	CreateOrUpdate(metav1.Object) error
@@ -81,11 +104,13 @@ type ComponentReconciler interface {
 	Create(ctx context.Context, obj client.Object, opts ...client.CreateOption) error
 	Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error
 	Patch(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.PatchOption) error
+	Delete(ctx context.Context, obj client.Object, opts ...client.DeleteOption) error
 
 	// custom methods which are managed by consumers
 	CheckReady() (bool, error)
 	Mutate(*metav1.Object) ([]metav1.Object, bool, error)
 	Wait(*metav1.Object) (bool, error)
+	PreDelete(*metav1.Object) (bool, error)
 }
 
 type ComponentResource interface {
@@ -97,6 +122,7 @@ type ComponentResource interface {
 	GetNamespace() string
 	GetObject() client.Object
 	GetReconciler() ComponentReconciler
+	GetWave() int
 
 	// equality checkers
 	EqualGVK(ComponentResource) bool
@@ -29,6 +29,36 @@ const conditionsTemplate = `{{ .Boilerplate }}
 
 package common
 
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionTypeReady is the type of the standard condition which indicates whether
+// a component has been successfully reconciled.
+const ConditionTypeReady = "Ready"
+
+// ReasonReconciled is the reason of the ready condition once all phases of a
+// component have completed.
+const ReasonReconciled = "Reconciled"
+
+// ConditionTypePaused is the type of the standard condition which indicates that the
+// reconciliation of a component is paused.
+const ConditionTypePaused = "Paused"
+
+// ReasonPaused is the reason of the paused condition.
+const ReasonPaused = "Paused"
+
+// ConditionTypeDrifted is the type of the standard condition which indicates whether
+// the child resources of a component which is observed only differ from their desired
+// state.
+const ConditionTypeDrifted = "Drifted"
+
+// ReasonDriftDetected and ReasonNoDrift are the reasons of the drifted condition.
+const (
+	ReasonDriftDetected = "DriftDetected"
+	ReasonNoDrift       = "NoDrift"
+)
+
 // PhaseState defines the current state of the phase.
 // +kubebuilder:validation:Enum=Complete;Reconciling;Failed;Pending
 type PhaseState string
@@ -68,6 +98,175 @@ type ResourceCondition struct {
 
 	// Message defines a helpful message from the resource phase.
 	Message string ` + "`" + `json:"message,omitempty"` + "`" + `
+
+	// Wave defines the wave in which this resource is created.  The resources of a wave
+	// are created once the resources of the previous waves are ready.
+	Wave int ` + "`" + `json:"wave"` + "`" + `
+
+	// Conflict defines the conflicts with other field managers when the resource
+	// is persisted with server-side apply and conflicts are not forced.
+	Conflict string ` + "`" + `json:"conflict,omitempty"` + "`" + `
+
+	// Drifted defines whether the resource differs from its desired state when the
+	// component is observed only.
+	Drifted bool ` + "`" + `json:"drifted,omitempty"` + "`" + `
+
+	// LastDriftTime defines the time at which changes made to the resource outside of
+	// the controller were last corrected.
+	LastDriftTime string ` + "`" + `json:"lastDriftTime,omitempty"` + "`" + `
+
+	// DriftCorrections defines the number of times that changes made to the resource
+	// outside of the controller were corrected.
+	DriftCorrections int ` + "`" + `json:"driftCorrections,omitempty"` + "`" + `
+
+	// DriftedPaths defines a summary of the paths of the resource which differed from
+	// the desired state when changes made outside of the controller were last corrected.
+	DriftedPaths string ` + "`" + `json:"driftedPaths,omitempty"` + "`" + `
+
+	// LastRecreateTime defines the time at which the resource was last deleted and
+	// created again because immutable fields were changed.
+	LastRecreateTime string ` + "`" + `json:"lastRecreateTime,omitempty"` + "`" + `
+
+	// RecreateReason defines the error of the update of the resource which caused it
+	// to be last deleted and created again.
+	RecreateReason string ` + "`" + `json:"recreateReason,omitempty"` + "`" + `
+}
+
+// ComponentStatus summarizes the status of a component of a collection.
+type ComponentStatus struct {
+	// Kind defines the kind of the component.
+	Kind string ` + "`" + `json:"kind"` + "`" + `
+
+	// Name defines the name of the component.
+	Name string ` + "`" + `json:"name"` + "`" + `
+
+	// Namespace defines the namespace of the component.
+	Namespace string ` + "`" + `json:"namespace,omitempty"` + "`" + `
+
+	// Ready defines whether the component is ready.
+	Ready bool ` + "`" + `json:"ready"` + "`" + `
+
+	// DependenciesSatisfied defines whether the dependencies of the component are satisfied.
+	DependenciesSatisfied bool ` + "`" + `json:"dependenciesSatisfied"` + "`" + `
+
+	// Phase defines the first phase of the component which has not completed, if any.
+	Phase string ` + "`" + `json:"phase,omitempty"` + "`" + `
+
+	// State defines the state of the phase which has not completed.
+	State PhaseState ` + "`" + `json:"state,omitempty"` + "`" + `
+
+	// Message defines the message of the phase which has not completed.
+	Message string ` + "`" + `json:"message,omitempty"` + "`" + `
+}
+
+// ComponentsStatus summarizes the status of the components of a collection.
+type ComponentsStatus struct {
+	// Total defines the number of components.
+	Total int ` + "`" + `json:"total"` + "`" + `
+
+	// Ready defines the number of components which are ready.
+	Ready int ` + "`" + `json:"ready"` + "`" + `
+
+	// Pending defines the number of components which are not ready and have not failed.
+	Pending int ` + "`" + `json:"pending"` + "`" + `
+
+	// Failed defines the number of components with a failed phase.
+	Failed int ` + "`" + `json:"failed"` + "`" + `
+
+	// Message defines the message of the first component which has failed or, if no
+	// component has failed, of the first component which is not ready.
+	Message string ` + "`" + `json:"message,omitempty"` + "`" + `
+
+	// Components defines the status of each component.
+	Components []ComponentStatus ` + "`" + `json:"components,omitempty"` + "`" + `
+}
+
+// NewComponentStatus returns the summarized status of a component.
+func NewComponentStatus(component Component) ComponentStatus {
+	status := ComponentStatus{
+		Kind:                  component.GetComponentGVK().Kind,
+		Ready:                 component.GetReadyStatus(),
+		DependenciesSatisfied: component.GetDependencyStatus(),
+	}
+
+	if object, ok := component.(metav1.Object); ok {
+		status.Name = object.GetName()
+		status.Namespace = object.GetNamespace()
+	}
+
+	for _, condition := range component.GetPhaseConditions() {
+		if condition.State != PhaseStateComplete {
+			status.Phase = condition.Phase
+			status.State = condition.State
+			status.Message = condition.Message
+
+			break
+		}
+	}
+
+	return status
+}
+
+// NewComponentsStatus returns the summarized status of the components of a collection.
+func NewComponentsStatus(components []Component) *ComponentsStatus {
+	status := &ComponentsStatus{Total: len(components)}
+
+	var pendingMessage string
+
+	for _, component := range components {
+		componentStatus := NewComponentStatus(component)
+		status.Components = append(status.Components, componentStatus)
+
+		message := componentStatus.Kind + " " + componentStatus.Name
+		if componentStatus.Message != "" {
+			message += ": " + componentStatus.Message
+		}
+
+		switch {
+		case componentStatus.Ready:
+			status.Ready++
+		case componentStatus.State == PhaseStateFailed:
+			status.Failed++
+
+			if status.Message == "" {
+				status.Message = message
+			}
+		default:
+			status.Pending++
+
+			if pendingMessage == "" {
+				pendingMessage = message
+			}
+		}
+	}
+
+	if status.Message == "" {
+		status.Message = pendingMessage
+	}
+
+	return status
+}
+
+// DeepCopyInto copies the summarized status of the components of a collection into out.
+func (in *ComponentsStatus) DeepCopyInto(out *ComponentsStatus) {
+	*out = *in
+
+	if in.Components != nil {
+		out.Components = make([]ComponentStatus, len(in.Components))
+		copy(out.Components, in.Components)
+	}
+}
+
+// DeepCopy copies the summarized status of the components of a collection.
+func (in *ComponentsStatus) DeepCopy() *ComponentsStatus {
+	if in == nil {
+		return nil
+	}
+
+	out := new(ComponentsStatus)
+	in.DeepCopyInto(out)
+
+	return out
 }
 
 // GetPhaseConditionIndex returns the index of a matching phase condition.  Any integer which is 0
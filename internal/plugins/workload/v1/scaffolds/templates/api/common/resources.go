@@ -47,6 +47,31 @@ type ResourceCommon struct {
 	Namespace string ` + "`" + `json:"namespace"` + "`" + `
 }
 
+// CollectionReference references the collection of a component.
+type CollectionReference struct {
+	// Name defines the name of the collection.
+	Name string ` + "`" + `json:"name"` + "`" + `
+
+	// Namespace defines the namespace of the collection.  It defaults to the namespace
+	// of the component and is ignored for cluster-scoped collections.
+	// +kubebuilder:validation:Optional
+	Namespace string ` + "`" + `json:"namespace,omitempty"` + "`" + `
+}
+
+// DependencyReference references the custom resource of a dependency of a component.
+type DependencyReference struct {
+	// Kind defines the kind of the dependency.
+	Kind string ` + "`" + `json:"kind"` + "`" + `
+
+	// Name defines the name of the dependency.
+	Name string ` + "`" + `json:"name"` + "`" + `
+
+	// Namespace defines the namespace of the dependency.  It defaults to the namespace
+	// of the component and is ignored for cluster-scoped dependencies.
+	// +kubebuilder:validation:Optional
+	Namespace string ` + "`" + `json:"namespace,omitempty"` + "`" + `
+}
+
 // Resource is the resource and its condition as stored on the object status field.
 type Resource struct {
 	ResourceCommon ` + "`" + `json:",omitempty"` + "`" + `
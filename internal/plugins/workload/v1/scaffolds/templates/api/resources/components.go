@@ -0,0 +1,163 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package resources
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	workloadv1 "github.com/vmware-tanzu-labs/operator-builder/internal/workload/v1"
+)
+
+var _ machinery.Template = &Components{}
+
+// Components scaffolds the functions which create the custom resources of the
+// components which are managed by a collection.
+type Components struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+	machinery.ResourceMixin
+
+	ClusterScoped     bool
+	PackageName       string
+	ManagedComponents []*workloadv1.ManagedComponent
+	IsComponent       bool
+	Collection        *workloadv1.WorkloadCollection
+
+	// Imports maps the import aliases of the API packages of the components,
+	// and of the collections above the collection, to their import paths.
+	Imports map[string]string
+}
+
+func (f *Components) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"apis",
+		f.Resource.Group,
+		f.Resource.Version,
+		f.PackageName,
+		"managed_components.go",
+	)
+
+	// the api packages of the collection and of the collection in which it is
+	// nested are imported by their alias already
+	imported := []string{f.Resource.ImportAlias()}
+	if f.IsComponent {
+		imported = append(imported, f.Collection.Spec.API.Group+f.Collection.Spec.API.Version)
+	}
+
+	components := make([]workloadv1.WorkloadAPIBuilder, len(f.ManagedComponents))
+	for i, component := range f.ManagedComponents {
+		components[i] = component
+	}
+
+	if f.IsComponent {
+		for _, ancestor := range f.Collection.GetAncestors() {
+			components = append(components, ancestor)
+		}
+	}
+
+	f.Imports = workloadv1.GetAPIImports(f.Repo, components, imported...)
+
+	f.TemplateBody = componentsTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+//nolint:lll
+const componentsTemplate = `{{ .Boilerplate }}
+
+package {{ .PackageName }}
+
+import (
+	"encoding/json"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	{{- if .IsComponent }}
+	{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }} "{{ .Repo }}/apis/{{ .Collection.Spec.API.Group }}/{{ .Collection.Spec.API.Version }}"
+	{{- end }}
+	{{- range $alias, $path := .Imports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
+)
+
+{{ if .ClusterScoped -}}
+// defaultComponentNamespace is the namespace in which the namespaced components of a
+// cluster-scoped collection are created unless another namespace is set.
+const defaultComponentNamespace = "default"
+{{ end -}}
+
+{{ range .ManagedComponents }}
+// {{ .CreateFuncName }} creates the {{ .GetAPIKind }} custom resource of the collection.  No
+// resource is returned if the component is disabled.
+func {{ .CreateFuncName }}(
+	parent *{{ $.Resource.ImportAlias }}.{{ $.Resource.Kind }},
+	{{- if $.IsComponent }}
+	collection *{{ $.Collection.Spec.API.Group }}{{ $.Collection.Spec.API.Version }}.{{ $.Collection.Spec.API.Kind }},
+	{{- range $.Collection.GetAncestors }}
+	{{ .GetVarName }} *{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }},
+	{{- end }}
+	{{ end -}}
+) (metav1.Object, error) {
+	options := parent.Spec.Components.{{ .GetAPIKind }}
+	if options.Enabled != nil && !*options.Enabled {
+		return nil, nil
+	}
+
+	spec := map[string]interface{}{}
+
+	if options.Spec != nil {
+		if err := json.Unmarshal(options.Spec.Raw, &spec); err != nil {
+			return nil, fmt.Errorf("unable to decode spec of component {{ .GetAPIKind }}, %w", err)
+		}
+	}
+
+	{{- if .InheritedFields }}
+	// fields which are not set for the component are taken from the collection
+	{{- range .InheritedFields }}
+	if _, found := spec["{{ .ManifestFieldName }}"]; !found {
+		spec["{{ .ManifestFieldName }}"] = parent.Spec.{{ title .ManifestFieldName }}
+	}
+	{{- end }}
+	{{ end }}
+
+	spec["collectionRef"] = map[string]interface{}{
+		"name":      parent.Name,
+		"namespace": parent.Namespace,
+	}
+
+	resourceObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": {{ .GetAPIGroup }}{{ .GetAPIVersion }}.GroupVersion.String(),
+			"kind":       "{{ .GetAPIKind }}",
+			"metadata": map[string]interface{}{
+				"name": parent.Name,
+			},
+			"spec": spec,
+		},
+	}
+
+	{{- if not .IsClusterScoped }}
+	{{ if $.ClusterScoped }}
+	namespace := options.Namespace
+	if namespace == "" {
+		namespace = defaultComponentNamespace
+	}
+
+	resourceObj.SetNamespace(namespace)
+	{{- else }}
+	resourceObj.SetNamespace(parent.Namespace)
+	{{- end }}
+	{{- end }}
+
+	return resourceObj, nil
+}
+{{ end }}
+`
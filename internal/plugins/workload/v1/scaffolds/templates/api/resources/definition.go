@@ -26,6 +26,10 @@ type Definition struct {
 	SpecFields    []*workloadv1.APISpecField
 	IsComponent   bool
 	Collection    *workloadv1.WorkloadCollection
+
+	// AncestorImports maps the import aliases of the API packages of the
+	// collections above the collection to their import paths.
+	AncestorImports map[string]string
 }
 
 func (f *Definition) SetTemplateDefaults() error {
@@ -37,6 +41,17 @@ func (f *Definition) SetTemplateDefaults() error {
 		f.SourceFile.Filename,
 	)
 
+	// the api packages of the workload and of its collection are imported by their
+	// alias already
+	if f.IsComponent {
+		f.AncestorImports = workloadv1.GetAncestorImports(
+			f.Repo,
+			f.Collection,
+			f.Resource.ImportAlias(),
+			f.Collection.Spec.API.Group+f.Collection.Spec.API.Version,
+		)
+	}
+
 	f.TemplateBody = definitionTemplate
 	f.IfExistsAction = machinery.OverwriteFile
 
@@ -59,8 +74,14 @@ import (
 	{{ end }}
 
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	{{- if .SourceFile.HasReadyChecks }}
+	"{{ .Repo }}/internal/resources"
+	{{- end }}
 	{{- if .IsComponent }}
 	{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }} "{{ .Repo }}/apis/{{ .Collection.Spec.API.Group }}/{{ .Collection.Spec.API.Version }}"
+	{{- range $alias, $path := .AncestorImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
 	{{ end -}}
 )
 
@@ -70,6 +91,9 @@ func Create{{ .UniqueName }} (
 	parent *{{ $.Resource.ImportAlias }}.{{ $.Resource.Kind }},
 	{{- if $.IsComponent }}
 	collection *{{ $.Collection.Spec.API.Group }}{{ $.Collection.Spec.API.Version }}.{{ $.Collection.Spec.API.Kind }},
+	{{- range $.Collection.GetAncestors }}
+	{{ .GetVarName }} *{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }},
+	{{- end }}
 	{{ end -}}
 ) (metav1.Object, error) {
 	{{- .SourceCode }}
@@ -80,5 +104,20 @@ func Create{{ .UniqueName }} (
 
 	return resourceObj, nil
 }
+{{ if .ReadyChecks }}
+// {{ .UniqueName }}IsReady determines if the {{ .Name }} {{ .Kind }} resource is ready
+// based on the ready expression: {{ .ReadyExpression }}
+func {{ .UniqueName }}IsReady(object *unstructured.Unstructured) (bool, error) {
+	return resources.FieldChecksAreTrue(object,
+		{{- range .ReadyChecks }}
+		resources.FieldCheck{
+			Path:     []string{ {{- range $i, $segment := .Path }}{{ if $i }}, {{ end }}{{ printf "%q" $segment }}{{ end -}} },
+			Operator: "{{ .Operator }}",
+			Value:    {{ .Value }},
+		},
+		{{- end }}
+	)
+}
+{{ end }}
 {{ end }}
 `
@@ -23,8 +23,18 @@ type Resources struct {
 	PackageName     string
 	CreateFuncNames []string
 	InitFuncNames   []string
+	ReadyFuncNames  []string
+	ResourceWaves   []int
 	IsComponent     bool
+
+	// RecreatePolicies are the recreate policies of the resource markers of the
+	// child resources, which are empty for child resources without one.
+	RecreatePolicies []string
 	Collection      *workloadv1.WorkloadCollection
+
+	// AncestorImports maps the import aliases of the API packages of the
+	// collections above the collection to their import paths.
+	AncestorImports map[string]string
 }
 
 func (f *Resources) SetTemplateDefaults() error {
@@ -36,6 +46,17 @@ func (f *Resources) SetTemplateDefaults() error {
 		"resources.go",
 	)
 
+	// the api packages of the workload and of its collection are imported by their
+	// alias already
+	if f.IsComponent {
+		f.AncestorImports = workloadv1.GetAncestorImports(
+			f.Repo,
+			f.Collection,
+			f.Resource.ImportAlias(),
+			f.Collection.Spec.API.Group+f.Collection.Spec.API.Version,
+		)
+	}
+
 	f.TemplateBody = resourcesTemplate
 	f.IfExistsAction = machinery.OverwriteFile
 
@@ -49,10 +70,14 @@ package {{ .PackageName }}
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
 	{{- if .IsComponent }}
 	{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }} "{{ .Repo }}/apis/{{ .Collection.Spec.API.Group }}/{{ .Collection.Spec.API.Version }}"
+	{{- range $alias, $path := .AncestorImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
 	{{ end -}}
 )
 
@@ -63,6 +88,9 @@ var CreateFuncs = []func(
 	*{{ .Resource.ImportAlias }}.{{ .Resource.Kind }},
 	{{- if $.IsComponent }}
 	*{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }},
+	{{- range .Collection.GetAncestors }}
+	*{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }},
+	{{- end }}
 	{{ end -}}
 ) (metav1.Object, error){
 	{{ range .CreateFuncNames }}
@@ -82,10 +110,40 @@ var InitFuncs = []func(
 	*{{ .Resource.ImportAlias }}.{{ .Resource.Kind }},
 	{{- if $.IsComponent }}
 	*{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }},
+	{{- range .Collection.GetAncestors }}
+	*{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }},
+	{{- end }}
 	{{ end -}}
 ) (metav1.Object, error){
 	{{ range .InitFuncNames }}
 		{{- . -}},
 	{{ end }}
 }
+
+// ReadyFuncs is an array of functions that determine the readiness of the child resources, in the same
+// order as the CreateFuncs.  A function is only set for child resources with a ready expression in their
+// resource marker.  The readiness of the other child resources is determined by their kind.
+var ReadyFuncs = []func(*unstructured.Unstructured) (bool, error){
+	{{ range .ReadyFuncNames }}
+		{{- if . }}{{ . }}{{ else }}nil{{ end -}},
+	{{ end }}
+}
+
+// Waves is an array of the waves in which the child resources are created, in the same order as the
+// CreateFuncs.  The child resources of a wave are created once the child resources of the previous
+// waves are ready.
+var Waves = []int{
+	{{ range .ResourceWaves }}
+		{{- . -}},
+	{{ end }}
+}
+
+// RecreatePolicies is an array of the recreate policies of the child resources, in the same order as
+// the CreateFuncs.  A policy is only set for child resources with a recreate policy in their resource
+// marker.  The recreate policy of the other child resources is determined by their kind.
+var RecreatePolicies = []string{
+	{{ range .RecreatePolicies }}
+		{{- printf "%q" . -}},
+	{{ end }}
+}
 `
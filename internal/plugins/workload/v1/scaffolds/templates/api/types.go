@@ -24,10 +24,13 @@ type Types struct {
 	machinery.RepositoryMixin
 	machinery.ResourceMixin
 
-	SpecFields    []*workloadv1.APISpecField
-	ClusterScoped bool
-	Dependencies  []*workloadv1.ComponentWorkload
-	IsStandalone  bool
+	SpecFields        []*workloadv1.APISpecField
+	ClusterScoped     bool
+	Dependencies      []*workloadv1.ComponentWorkload
+	ManagedComponents []*workloadv1.ManagedComponent
+	IsStandalone      bool
+	IsComponent       bool
+	IsCollection      bool
 }
 
 // SetTemplateDefaults implements file.Template.
@@ -56,7 +59,11 @@ package {{ .Resource.Version }}
 import (
 	"time"
 
+	{{- if .ManagedComponents }}
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 
 	"{{ .Repo }}/apis/common"
@@ -77,6 +84,24 @@ import (
 type {{ .Resource.Kind }}Spec struct {
 	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
+	{{ if .IsComponent }}
+	// CollectionRef references the collection of the component.  It may be omitted
+	// when only one collection exists in the cluster.
+	// +kubebuilder:validation:Optional
+	CollectionRef *common.CollectionReference ` + "`" + `json:"collectionRef,omitempty"` + "`" + `
+	{{ end }}
+	{{ if .Dependencies }}
+	// DependencyRefs references the custom resources of the dependencies of the component.
+	// A dependency without a reference is the only custom resource of its kind which belongs
+	// to the collection of the component, preferring those in the namespace of the component.
+	// +kubebuilder:validation:Optional
+	DependencyRefs []common.DependencyReference ` + "`" + `json:"dependencyRefs,omitempty"` + "`" + `
+	{{ end }}
+	{{ if .ManagedComponents }}
+	// Components configures the components which are created by the collection.
+	// +kubebuilder:validation:Optional
+	Components {{ .Resource.Kind }}Components ` + "`" + `json:"components,omitempty"` + "`" + `
+	{{ end }}
 
 	{{ range .SpecFields }}
 		{{ if .DefaultVal }}
@@ -90,19 +115,64 @@ type {{ .Resource.Kind }}Spec struct {
 	{{ end }}
 }
 
+{{ if .ManagedComponents -}}
+// {{ .Resource.Kind }}Components configures the components which are created by a {{ .Resource.Kind }}.
+type {{ .Resource.Kind }}Components struct {
+	{{- range .ManagedComponents }}
+	// +kubebuilder:validation:Optional
+	{{ .GetAPIKind }} {{ $.Resource.Kind }}{{ .GetAPIKind }} ` + "`" + `json:"{{ .FieldName }},omitempty"` + "`" + `
+	{{ end }}
+}
+
+{{ range .ManagedComponents -}}
+// {{ $.Resource.Kind }}{{ .GetAPIKind }} configures the {{ .GetAPIKind }} which is created by a {{ $.Resource.Kind }}.
+type {{ $.Resource.Kind }}{{ .GetAPIKind }} struct {
+	// Enabled determines if the {{ .GetAPIKind }} is created.  It is created unless it is disabled.
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Enabled *bool ` + "`" + `json:"enabled,omitempty"` + "`" + `
+	{{ if and $.ClusterScoped (not .IsClusterScoped) }}
+	// Namespace is the namespace in which the {{ .GetAPIKind }} is created.  It defaults to the
+	// "default" namespace.
+	// +kubebuilder:validation:Optional
+	Namespace string ` + "`" + `json:"namespace,omitempty"` + "`" + `
+	{{ end }}
+	// Spec is the spec of the {{ .GetAPIKind }}.  Fields which are not set are taken from the field
+	// of the same name of the collection spec, if one exists, or are otherwise defaulted by the
+	// {{ .GetAPIKind }} API.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Type=object
+	Spec *apiextensionsv1.JSON ` + "`" + `json:"spec,omitempty"` + "`" + `
+}
+
+{{ end -}}
+{{ end -}}
 // {{ .Resource.Kind }}Status defines the observed state of {{ .Resource.Kind }}.
 type {{ .Resource.Kind }}Status struct {
 	// INSERT ADDITIONAL STATUS FIELD - define observed state of cluster
 	// Important: Run "make" to regenerate code after modifying this file
 
-	Created               bool                       ` + "`" + `json:"created,omitempty"` + "`" + `
-	DependenciesSatisfied bool                       ` + "`" + `json:"dependenciesSatisfied,omitempty"` + "`" + `
-	Conditions            []common.PhaseCondition    ` + "`" + `json:"conditions,omitempty"` + "`" + `
-	Resources             []common.Resource          ` + "`" + `json:"resources,omitempty"` + "`" + `
+	Created               bool  ` + "`" + `json:"created,omitempty"` + "`" + `
+	DependenciesSatisfied bool  ` + "`" + `json:"dependenciesSatisfied,omitempty"` + "`" + `
+	ObservedGeneration    int64 ` + "`" + `json:"observedGeneration,omitempty"` + "`" + `
+
+	// +listType=map
+	// +listMapKey=type
+	Conditions      []metav1.Condition      ` + "`" + `json:"conditions,omitempty"` + "`" + `
+	PhaseConditions []common.PhaseCondition ` + "`" + `json:"phaseConditions,omitempty"` + "`" + `
+	Resources       []common.Resource       ` + "`" + `json:"resources,omitempty"` + "`" + `
+	{{- if .IsCollection }}
+
+	// Components summarizes the status of the components of the collection.
+	Components *common.ComponentsStatus ` + "`" + `json:"components,omitempty"` + "`" + `
+	{{- end }}
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=` + "`" + `.status.conditions[?(@.type=="Ready")].status` + "`" + `
+// +kubebuilder:printcolumn:name="Reason",type=string,JSONPath=` + "`" + `.status.conditions[?(@.type=="Ready")].reason` + "`" + `
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=` + "`" + `.metadata.creationTimestamp` + "`" + `
 {{- if .ClusterScoped }}
 // +kubebuilder:resource:scope=Cluster
 {{ end }}
@@ -148,7 +218,7 @@ func (component *{{ .Resource.Kind }}) SetDependencyStatus(dependencyStatus bool
 
 // GetPhaseConditions returns the phase conditions for a component.
 func (component {{ .Resource.Kind }}) GetPhaseConditions() []common.PhaseCondition {
-	return component.Status.Conditions
+	return component.Status.PhaseConditions
 }
 
 // SetPhaseCondition sets the phase conditions for a component.
@@ -157,12 +227,44 @@ func (component *{{ .Resource.Kind }}) SetPhaseCondition(condition common.PhaseC
 		if condition.LastModified == "" {
 			condition.LastModified = time.Now().UTC().String()
 		}
-		component.Status.Conditions[found] = condition
+		component.Status.PhaseConditions[found] = condition
 	} else {
-		component.Status.Conditions = append(component.Status.Conditions, condition)
+		component.Status.PhaseConditions = append(component.Status.PhaseConditions, condition)
 	}
 }
 
+// SetReadyCondition sets the ready condition for a component and records the
+// generation of the component which it was observed for.
+func (component *{{ .Resource.Kind }}) SetReadyCondition(status metav1.ConditionStatus, reason, message string) {
+	component.Status.ObservedGeneration = component.Generation
+
+	meta.SetStatusCondition(&component.Status.Conditions, metav1.Condition{
+		Type:               common.ConditionTypeReady,
+		Status:             status,
+		ObservedGeneration: component.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// GetConditions returns the standard conditions for a component.
+func (component *{{ .Resource.Kind }}) GetConditions() []metav1.Condition {
+	return component.Status.Conditions
+}
+
+// SetCondition sets a standard condition for a component, recording the generation
+// of the component which it was observed for.
+func (component *{{ .Resource.Kind }}) SetCondition(condition metav1.Condition) {
+	condition.ObservedGeneration = component.Generation
+
+	meta.SetStatusCondition(&component.Status.Conditions, condition)
+}
+
+// RemoveCondition removes a standard condition from a component.
+func (component *{{ .Resource.Kind }}) RemoveCondition(conditionType string) {
+	meta.RemoveStatusCondition(&component.Status.Conditions, conditionType)
+}
+
 // GetResources returns the resources for a component.
 func (component {{ .Resource.Kind }}) GetResources() []common.Resource {
 	return component.Status.Resources
@@ -181,6 +283,13 @@ func (component *{{ .Resource.Kind }}) SetResource(resource common.Resource) {
 	}
 }
 
+// RemoveResource removes a resource from the status of a component.
+func (component *{{ .Resource.Kind }}) RemoveResource(resource common.Resource) {
+	if found := resource.GetResourceIndex(component); found >= 0 {
+		component.Status.Resources = append(component.Status.Resources[:found], component.Status.Resources[found+1:]...)
+	}
+}
+
 // GetDependencies returns the dependencies for a component.
 func (*{{ .Resource.Kind }}) GetDependencies() []common.Component {
 	return []common.Component{
@@ -194,6 +303,20 @@ func (*{{ .Resource.Kind }}) GetDependencies() []common.Component {
 	}
 }
 
+{{ if .IsComponent -}}
+// GetCollectionRef returns the reference to the collection of a component.
+func (component *{{ .Resource.Kind }}) GetCollectionRef() *common.CollectionReference {
+	return component.Spec.CollectionRef
+}
+
+{{ end -}}
+{{ if .Dependencies -}}
+// GetDependencyRefs returns the references to the dependencies of a component.
+func (component *{{ .Resource.Kind }}) GetDependencyRefs() []common.DependencyReference {
+	return component.Spec.DependencyRefs
+}
+
+{{ end -}}
 // GetComponentGVK returns a GVK object for the component.
 func (*{{ .Resource.Kind }}) GetComponentGVK() schema.GroupVersionKind {
 	return schema.GroupVersionKind{
@@ -35,6 +35,14 @@ type CmdGenerateSub struct {
 	ComponentResource *resource.Resource
 	Collection        *workloadv1.WorkloadCollection
 
+	// Ancestors are the collections above the collection of a component, whose
+	// manifests are needed to generate the child resources of the component.
+	Ancestors []*workloadv1.WorkloadCollection
+
+	// AncestorImports maps the import aliases of the API packages of the
+	// ancestors to their import paths.
+	AncestorImports map[string]string
+
 	GenerateCommandName  string
 	GenerateCommandDescr string
 }
@@ -46,6 +54,16 @@ func (f *CmdGenerateSub) SetTemplateDefaults() error {
 			fmt.Sprintf("%s_generate.go", f.SubCmdFileName),
 		)
 		f.Resource = f.ComponentResource
+
+		if !f.IsCollection {
+			f.Ancestors = f.Collection.GetAncestors()
+			f.AncestorImports = workloadv1.GetAncestorImports(
+				f.Repo,
+				f.Collection,
+				f.Resource.ImportAlias(),
+				f.Collection.Spec.API.Group+f.Collection.Spec.API.Version,
+			)
+		}
 	} else {
 		f.Path = filepath.Join("cmd", f.RootCmd, "commands", "generate.go")
 	}
@@ -79,6 +97,9 @@ import (
 	"{{ .Resource.Path }}/{{ .PackageName }}"
 	{{- if .IsComponent }}
 	{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }} "{{ .Repo }}/apis/{{ .Collection.Spec.API.Group }}/{{ .Collection.Spec.API.Version }}"
+	{{- range $alias, $path := .AncestorImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
 	{{ end -}}
 )
 
@@ -92,6 +113,9 @@ type generate{{ .SubCmdVarName }}Command struct {
 	*cobra.Command
 	workloadManifest string
 	collectionManifest string
+	{{- range .Ancestors }}
+	{{ .GetVarName }}Manifest string
+	{{- end }}
 }
 {{- else }}
 type generateCommand struct {
@@ -158,6 +182,17 @@ func (g *generateCommand) newGenerate{{ .SubCmdVarName }}Command() {
 		"Filepath to the workload collection manifest.",
 	)
 	generate{{ .SubCmdVarName }}Cmd.MarkFlagRequired("collection-manifest")
+	{{- range .Ancestors }}
+
+	generate{{ $.SubCmdVarName }}Cmd.Command.Flags().StringVarP(
+		&generate{{ $.SubCmdVarName }}Cmd.{{ .GetVarName }}Manifest,
+		"{{ .Spec.API.Kind | lower }}-manifest",
+		"",
+		"",
+		"Filepath to the {{ .Spec.API.Kind }} collection manifest of the workload collection.",
+	)
+	generate{{ $.SubCmdVarName }}Cmd.MarkFlagRequired("{{ .Spec.API.Kind | lower }}-manifest")
+	{{- end }}
 
 	g.AddCommand(generate{{ .SubCmdVarName }}Cmd.Command)
 
@@ -224,6 +259,28 @@ func (g *generateCommand) generate(cmd *cobra.Command, args []string) error {
 	if err != nil {
 		return fmt.Errorf("error validating yaml %s, %w", colFilename, err)
 	}
+	{{- range .Ancestors }}
+
+	// {{ .Spec.API.Kind }} collection above the workload collection
+	{{ .GetVarName }}Filename, _ := filepath.Abs(g.{{ .GetVarName }}Manifest)
+
+	{{ .GetVarName }}YamlFile, err := ioutil.ReadFile({{ .GetVarName }}Filename)
+	if err != nil {
+		return fmt.Errorf("failed to open file %s, %w", {{ .GetVarName }}Filename, err)
+	}
+
+	var {{ .GetVarName }} {{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }}
+
+	err = yaml.Unmarshal({{ .GetVarName }}YamlFile, &{{ .GetVarName }})
+	if err != nil {
+		return fmt.Errorf("failed to unmarshal yaml %s into workload, %w", {{ .GetVarName }}Filename, err)
+	}
+
+	err = validateWorkload(&{{ .GetVarName }})
+	if err != nil {
+		return fmt.Errorf("error validating yaml %s, %w", {{ .GetVarName }}Filename, err)
+	}
+	{{- end }}
 
 	resourceObjects := make([]metav1.Object, len({{ .PackageName }}.CreateFuncs))
 
@@ -231,7 +288,7 @@ func (g *generateCommand) generate(cmd *cobra.Command, args []string) error {
 		{{ if .IsCollection }}
 		resource, err := f(&collection)
 		{{- else }}
-		resource, err := f(&workload, &collection)
+		resource, err := f(&workload, &collection{{ range .Ancestors }}, &{{ .GetVarName }}{{ end }})
 		{{- end }}
 		if err != nil {
 			return err
@@ -276,6 +333,11 @@ func (g *generateCommand) generate(cmd *cobra.Command, args []string) error {
 	outputStream := os.Stdout
 
 	for _, o := range resourceObjects {
+		// skip resources which are not desired, e.g. disabled components
+		if o == nil {
+			continue
+		}
+
 		if _, err := outputStream.WriteString("---\n"); err != nil {
 			return fmt.Errorf("failed to write output, %w", err)
 		}
@@ -32,6 +32,9 @@ type CmdInit struct {
 	InitCommandDescr string
 
 	SubCommands *[]workloadv1.CliCommand
+
+	// Components are the components of the collection in install order.
+	Components []*workloadv1.ComponentWorkload
 }
 
 func (f *CmdInit) SetTemplateDefaults() error {
@@ -64,7 +67,16 @@ func (c *{{ .RootCmdVarName }}Command) newInitCommand() {
 	initCmd.Command = &cobra.Command{
 		Use:   "{{ .InitCommandName }}",
 		Short: "{{ .InitCommandDescr }}",
-		Long: "{{ .InitCommandDescr }}",
+		{{- if .Components }}
+		Long: "{{ .InitCommandDescr }}.\n\n" +
+			"Components are installed in the following order:\n" +
+			{{- range $component := .Components }}
+			"  - {{ $component.Spec.API.Kind }} ({{ $component.Name }})\n" +
+			{{- end }}
+			"",
+		{{- else }}
+		Long:  "{{ .InitCommandDescr }}",
+		{{- end }}
 	}
 
 	initCmd.addCommands()
@@ -0,0 +1,42 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package rbac
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &RoleBinding{}
+
+// RoleBinding scaffolds the binding of the manager role to the service account of a
+// namespace-scoped operator, in place of the cluster role binding.
+type RoleBinding struct {
+	machinery.TemplateMixin
+}
+
+func (f *RoleBinding) SetTemplateDefaults() error {
+	f.Path = filepath.Join("config", "rbac", "role_binding.yaml")
+
+	f.TemplateBody = roleBindingTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+const roleBindingTemplate = `apiVersion: rbac.authorization.k8s.io/v1
+kind: RoleBinding
+metadata:
+  name: manager-rolebinding
+  namespace: system
+roleRef:
+  apiGroup: rbac.authorization.k8s.io
+  kind: Role
+  name: manager-role
+subjects:
+- kind: ServiceAccount
+  name: controller-manager
+  namespace: system
+`
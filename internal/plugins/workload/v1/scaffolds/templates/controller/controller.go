@@ -29,6 +29,30 @@ type Controller struct {
 	IsStandalone      bool
 	IsComponent       bool
 	Collection        *workloadv1.WorkloadCollection
+
+	// Ancestors are the collections above the collection of a component, up to
+	// the root collection, which are fetched by following the collection reference
+	// of each collection.
+	Ancestors []*workloadv1.WorkloadCollection
+
+	// Children are the components and nested collections of a collection, whose
+	// status is summarized on the collection.
+	Children []workloadv1.WorkloadAPIBuilder
+
+	// Dependencies are the components which a component depends upon.
+	Dependencies []*workloadv1.ComponentWorkload
+
+	// NamespaceScoped determines whether the operator only watches the namespaces
+	// which it is configured to watch, in which case the rbac rules are generated
+	// as a role rather than a cluster role.
+	NamespaceScoped bool
+
+	// Tracing determines whether the reconciliation is traced.
+	Tracing bool
+
+	// APIImports maps the import aliases of the API packages of the children,
+	// dependencies and ancestors to their import paths.
+	APIImports map[string]string
 }
 
 func (f *Controller) SetTemplateDefaults() error {
@@ -38,6 +62,25 @@ func (f *Controller) SetTemplateDefaults() error {
 		fmt.Sprintf("%s_controller.go", utils.ToFileName(f.Resource.Kind)),
 	)
 
+	// the api packages of the workload and of its collection are imported by their
+	// alias already
+	imported := []string{f.Resource.ImportAlias()}
+	if f.IsComponent {
+		imported = append(imported, f.Collection.Spec.API.Group+f.Collection.Spec.API.Version)
+		f.Ancestors = f.Collection.GetAncestors()
+	}
+
+	workloads := append([]workloadv1.WorkloadAPIBuilder{}, f.Children...)
+	for _, dependency := range f.Dependencies {
+		workloads = append(workloads, dependency)
+	}
+
+	for _, ancestor := range f.Ancestors {
+		workloads = append(workloads, ancestor)
+	}
+
+	f.APIImports = workloadv1.GetAPIImports(f.Repo, workloads, imported...)
+
 	f.TemplateBody = controllerTemplate
 	f.IfExistsAction = machinery.OverwriteFile
 
@@ -51,31 +94,52 @@ package {{ .Resource.Group }}
 
 import (
 	"context"
-	"fmt"
+	{{- if .IsComponent }}
+	goerrors "errors"
+	{{- end }}
 	"time"
 
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/api/errors"
+	{{- if .Children }}
+	"k8s.io/apimachinery/pkg/api/meta"
+	{{- end }}
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller"
+	{{- if or .IsComponent .Children }}
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	{{- end }}
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 
 	"{{ .Repo }}/apis/common"
 	{{ .Resource.ImportAlias }} "{{ .Resource.Path }}"
+	{{- range $alias, $path := .APIImports }}
+	{{ $alias }} "{{ $path }}"
+	{{- end }}
 	{{ if .IsComponent -}}
 	{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }} "{{ .Repo }}/apis/{{ .Collection.Spec.API.Group }}/{{ .Collection.Spec.API.Version }}"
 	{{ end }}
 	{{- if .HasChildResources -}}
 	"{{ .Resource.Path }}/{{ .PackageName }}"
 	{{ end -}}
-	"{{ .Repo }}/internal/controllers/phases"
 	"{{ .Repo }}/internal/controllers/utils"
+	"{{ .Repo }}/internal/delete"
 	"{{ .Repo }}/internal/dependencies"
+	{{- if or .IsComponent .Children }}
+	"{{ .Repo }}/internal/helpers"
+	{{- end }}
 	"{{ .Repo }}/internal/mutate"
 	"{{ .Repo }}/internal/resources"
+	{{- if .Tracing }}
+	"{{ .Repo }}/internal/tracing"
+	{{- end }}
 	"{{ .Repo }}/internal/wait"
 )
 
@@ -87,18 +151,23 @@ type {{ .Resource.Kind }}Reconciler struct {
 	Scheme     *runtime.Scheme
 	Context    context.Context
 	Controller controller.Controller
-	Watches    []client.Object
+	Recorder   record.EventRecorder
 	Resources  []common.ComponentResource
 	Component  *{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}
 	{{- if .IsComponent }}
 	Collection *{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }}
+	{{- range .Ancestors }}
+	{{ .Spec.API.Kind }} *{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }}
+	{{- end }}
 	{{ end }}
 }
 
-// +kubebuilder:rbac:groups={{ .Resource.Group }}.{{ .Resource.Domain }},resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete
-// +kubebuilder:rbac:groups={{ .Resource.Group }}.{{ .Resource.Domain }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups={{ .Resource.Group }}.{{ .Resource.Domain }},resources={{ .Resource.Plural }},verbs=get;list;watch;create;update;patch;delete{{ if $.NamespaceScoped }},namespace=system{{ end }}
+// +kubebuilder:rbac:groups={{ .Resource.Group }}.{{ .Resource.Domain }},resources={{ .Resource.Plural }}/status,verbs=get;update;patch{{ if $.NamespaceScoped }},namespace=system{{ end }}
+// +kubebuilder:rbac:groups={{ .Resource.Group }}.{{ .Resource.Domain }},resources={{ .Resource.Plural }}/finalizers,verbs=update{{ if $.NamespaceScoped }},namespace=system{{ end }}
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch{{ if $.NamespaceScoped }},namespace=system{{ end }}
 {{ range .RBACRules -}}
-// +kubebuilder:rbac:groups={{ .Group }},resources={{ .Resource }},verbs={{ .VerbString }}
+// +kubebuilder:rbac:groups={{ .Group }},resources={{ .Resource }},verbs={{ .VerbString }}{{ if $.NamespaceScoped }},namespace=system{{ end }}
 {{ end }}
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
@@ -110,37 +179,87 @@ type {{ .Resource.Kind }}Reconciler struct {
 //
 // For more details, check Reconcile and its Result here:
 // - https://pkg.go.dev/sigs.k8s.io/controller-runtime@v0.7.2/pkg/reconcile
+{{- if .Tracing }}
+func (r *{{ .Resource.Kind }}Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	r.Component = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+
+	// trace the reconciliation; the phases are traced as children of this span
+	ctx, span := tracing.Start(ctx, "Reconcile", tracing.ObjectAttributes(
+		r.Component.GetComponentGVK(),
+		req.Name,
+		req.Namespace,
+	)...)
+	defer func() { tracing.End(span, err) }()
+{{- else }}
 func (r *{{ .Resource.Kind }}Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	r.Component = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
+{{- end }}
+
 	r.Context = ctx
 	log := r.Log.WithValues("{{ .Resource.Kind | lower }}", req.NamespacedName)
 
 	// get and store the component
-	r.Component = &{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}
 	if err := r.Get(r.Context, req.NamespacedName, r.Component); err != nil {
 		log.V(0).Info("unable to fetch {{ .Resource.Kind }}")
 
+		// stop watching the kinds of child resources which only the deleted component produced
+		if errors.IsNotFound(err) {
+			utils.Unwatch(r, req.NamespacedName)
+		}
+
 		return ctrl.Result{}, utils.IgnoreNotFound(err)
 	}
 
-	{{ if .IsComponent }}
-	// get and store the collection
-	var collectionList {{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }}List
-
-	if err := r.List(r.Context, &collectionList); err != nil {
+	// report whether the component is paused
+	if err := utils.SetModeConditions(r); err != nil {
 		return ctrl.Result{}, err
 	}
 
-	if len(collectionList.Items) == 0 {
-		log.V(0).Info("no collections available; initiating controller requeue")
+	// execute the delete phases if the component is being deleted, which do not
+	// require the collection or the desired resources; deletion is not paused so
+	// that a paused component can still be deleted
+	if !r.Component.GetDeletionTimestamp().IsZero() {
+		return utils.ExecutePhases(r, utils.Phases(r.Component))
+	}
 
-		return ctrl.Result{Requeue: true}, nil
-	} else if len(collectionList.Items) > 1 {
-		log.V(0).Info("multiple collections found; expected 1; cannot proceed")
+	// skip all other phases while the component is paused
+	if utils.IsPaused(r.Component) {
+		log.V(0).Info("reconciliation is paused; skipping all phases")
 
 		return ctrl.Result{}, nil
 	}
 
-	r.Collection = &collectionList.Items[0]
+	// register the finalizer so that child resources are deleted with the component
+	if err := utils.RegisterFinalizer(r); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	{{ if .IsComponent }}
+	// get and store the collection, either by reference or as the only collection in the cluster
+	{{- if .Ancestors }}, and
+	// the collections above it
+	{{- end }}
+	if err := r.SetCollections(); err != nil {
+		switch {
+		case goerrors.Is(err, helpers.ErrCollectionNotFound):
+			log.V(0).Info(err.Error() + "; initiating controller requeue")
+
+			return ctrl.Result{Requeue: true}, nil
+		case goerrors.Is(err, helpers.ErrCollectionNotUnique):
+			log.V(0).Info(err.Error() + "; cannot proceed")
+
+			return ctrl.Result{}, nil
+		}
+
+		return ctrl.Result{}, err
+	}
+	{{ end }}
+	{{- if .Children }}
+	// summarize the status of the components of the collection, which is persisted with the
+	// status of the phases
+	if err := r.SetComponentsStatus(); err != nil {
+		return ctrl.Result{}, err
+	}
 	{{ end }}
 
 	// get and store the resources
@@ -148,23 +267,24 @@ func (r *{{ .Resource.Kind }}Reconciler) Reconcile(ctx context.Context, req ctrl
 		return ctrl.Result{}, err
 	}
 
-	// execute the phases
-	for _, phase := range utils.Phases(r.Component) {
-		r.GetLogger().V(7).Info(fmt.Sprintf("enter phase: %T", phase))
-		proceed, err := phase.Execute(r)
-		result, err := phases.HandlePhaseExit(r, phase, proceed, err)
+	// watch the kinds of the desired resources and stop watching the kinds which are no longer desired
+	watched, err := utils.Watch(r)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
 
-		// return only if we have an error or are told not to proceed
-		if err != nil || !proceed {
-			log.V(2).Info(fmt.Sprintf("not ready; requeuing phase: %T", phase))
+	// execute the phases
+	phaseResult, phaseErr := utils.ExecutePhases(r, utils.Phases(r.Component))
 
-			return result, err
-		}
+	// requeue until the kinds of all desired resources are watched, as the changes to the child
+	// resources of a kind which is not yet known to the API server are not observed
+	if phaseErr == nil && !watched && !phaseResult.Requeue && phaseResult.RequeueAfter == 0 {
+		log.V(2).Info("kinds of desired resources are not yet known; initiating controller requeue")
 
-		r.GetLogger().V(5).Info(fmt.Sprintf("completed phase: %T", phase))
+		return ctrl.Result{Requeue: true}, nil
 	}
 
-	return phases.DefaultReconcileResult(), nil
+	return phaseResult, phaseErr
 }
 
 // Construct resources runs the methods to properly construct the resources.
@@ -174,7 +294,7 @@ func (r *{{ .Resource.Kind }}Reconciler) ConstructResources() ([]metav1.Object,
 
 	// create resources in memory
 	for i, f := range {{ .PackageName }}.CreateFuncs {
-		resource, err := f(r.Component{{ if .IsComponent }}, r.Collection){{ else }}){{ end }}
+		resource, err := f(r.Component{{ if .IsComponent }}, r.Collection{{ range .Ancestors }}, r.{{ .Spec.API.Kind }}{{ end }}){{ else }}){{ end }}
 		if err != nil {
 			return nil, err
 		}
@@ -201,8 +321,16 @@ func (r *{{ .Resource.Kind }}Reconciler) SetResources() error {
 		return err
 	}
 
+	// reset the resources of a previous reconciliation, which may belong to another component
+	r.Resources = nil
+
 	// loop through the in memory resources and store them on the reconciler
-	for _, base := range baseResources {
+	for {{ if .HasChildResources }}i{{ else }}_{{ end }}, base := range baseResources {
+		// skip resources which are not desired, e.g. disabled components
+		if base == nil {
+			continue
+		}
+
 		// run through the mutation functions to mutate the resources
 		mutatedResources, skip, err := r.Mutate(&base)
 		if err != nil {
@@ -215,12 +343,19 @@ func (r *{{ .Resource.Kind }}Reconciler) SetResources() error {
 		for _, mutated := range mutatedResources {
 			resourceObject := resources.NewResourceFromClient(mutated.(client.Object))
 			resourceObject.Reconciler = r
+			{{- if .HasChildResources }}
+			resourceObject.ReadyFunc = {{ .PackageName }}.ReadyFuncs[i]
+			resourceObject.Wave = {{ .PackageName }}.Waves[i]
+			resourceObject.RecreatePolicy = resources.RecreatePolicy({{ .PackageName }}.RecreatePolicies[i])
+			{{- end }}
 
 			r.SetResource(resourceObject)
 		}
 	}
 
-	return nil
+	// stamp the hash of the config which is referenced by workloads on their pod templates, if
+	// enabled, so that the workloads are restarted when the config changes
+	return resources.SetConfigHashes(r.Resources)
 }
 
 // SetResource will set a resource on the objects if the relevant object does not already exist.
@@ -252,8 +387,8 @@ func (r *{{ .Resource.Kind }}Reconciler) CreateOrUpdate(
 	resource metav1.Object,
 ) error {
 	// set ownership on the underlying resource being created or updated
-	if err := ctrl.SetControllerReference(r.Component, resource, r.Scheme); err != nil {
-		r.GetLogger().V(0).Info("unable to set owner reference on resource")
+	if err := utils.SetOwner(r, resource); err != nil {
+		r.GetLogger().V(0).Info("unable to set ownership on resource")
 
 		return err
 	}
@@ -285,7 +420,7 @@ func (r *{{ .Resource.Kind }}Reconciler) CreateOrUpdate(
 		}
 	}
 
-	return utils.Watch(r, newResource.Object)
+	return nil
 }
 
 // GetLogger returns the logger from the reconciler.
@@ -308,11 +443,37 @@ func (r *{{ .Resource.Kind }}Reconciler) GetContext() context.Context {
 	return r.Context
 }
 
+// SetContext sets the context of the reconciler.
+func (r *{{ .Resource.Kind }}Reconciler) SetContext(ctx context.Context) {
+	r.Context = ctx
+}
+
 // GetName returns the name of the reconciler.
 func (r *{{ .Resource.Kind }}Reconciler) GetName() string {
 	return r.Name
 }
 
+// GetCollection returns the collection of the component the reconciler is operating against.
+{{- if .IsComponent }}  This is
+// the collection in which the component is directly nested
+{{- if .Ancestors }}; the collections above it are stored
+// on the reconciler by their kind
+{{- end }}.
+{{- else }}  A workload
+// which is not a component of a collection is its own collection.
+{{- end }}
+func (r *{{ .Resource.Kind }}Reconciler) GetCollection() client.Object {
+	{{- if .IsComponent }}
+	if r.Collection == nil {
+		return nil
+	}
+
+	return r.Collection
+	{{- else }}
+	return r.Component
+	{{- end }}
+}
+
 // GetComponent returns the component the reconciler is operating against.
 func (r *{{ .Resource.Kind }}Reconciler) GetComponent() common.Component {
 	return r.Component
@@ -323,14 +484,9 @@ func (r *{{ .Resource.Kind }}Reconciler) GetController() controller.Controller {
 	return r.Controller
 }
 
-// GetWatches returns the objects which are current being watched by the reconciler.
-func (r *{{ .Resource.Kind }}Reconciler) GetWatches() []client.Object {
-	return r.Watches
-}
-
-// SetWatch appends a watch to the list of currently watched objects.
-func (r *{{ .Resource.Kind }}Reconciler) SetWatch(watch client.Object) {
-	r.Watches = append(r.Watches, watch)
+// GetEventRecorder returns the event recorder associated with the reconciler.
+func (r *{{ .Resource.Kind }}Reconciler) GetEventRecorder() record.EventRecorder {
+	return r.Recorder
 }
 
 // UpdateStatus updates the status for a component.
@@ -357,6 +513,13 @@ func (r *{{ .Resource.Kind }}Reconciler) Wait(
 	return wait.{{ .Resource.Kind }}Wait(r, object)
 }
 
+// PreDelete will run the custom logic prior to the deletion of a resource.
+func (r *{{ .Resource.Kind }}Reconciler) PreDelete(
+	object *metav1.Object,
+) (bool, error) {
+	return delete.{{ .Resource.Kind }}PreDelete(r, object)
+}
+
 func (r *{{ .Resource.Kind }}Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 	options := controller.Options{
 		RateLimiter: utils.NewDefaultRateLimiter(5*time.Microsecond, 5*time.Minute),
@@ -364,15 +527,211 @@ func (r *{{ .Resource.Kind }}Reconciler) SetupWithManager(mgr ctrl.Manager) erro
 
 	baseController, err := ctrl.NewControllerManagedBy(mgr).
 		WithOptions(options).
-		WithEventFilter(utils.ComponentPredicates()).
-		For(&{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}).
+		For(&{{ .Resource.ImportAlias }}.{{ .Resource.Kind }}{}, builder.WithPredicates(utils.ComponentPredicates())).
+		{{- if .IsComponent }}
+		Watches(
+			&source.Kind{Type: &{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }}{}},
+			handler.EnqueueRequestsFromMapFunc(r.collectionToComponents),
+			builder.WithPredicates(utils.ComponentPredicates()),
+		).
+		{{- end }}
+		{{- range .Ancestors }}
+		Watches(
+			&source.Kind{Type: &{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }}{}},
+			handler.EnqueueRequestsFromMapFunc(r.ancestorToComponents),
+			builder.WithPredicates(utils.ComponentPredicates()),
+		).
+		{{- end }}
+		{{- range .Children }}
+		Watches(
+			&source.Kind{Type: &{{ .GetAPIGroup }}{{ .GetAPIVersion }}.{{ .GetAPIKind }}{}},
+			handler.EnqueueRequestsFromMapFunc(r.componentToCollections),
+			builder.WithPredicates(utils.ComponentStatusPredicates()),
+		).
+		{{- end }}
+		{{- range .Dependencies }}
+		Watches(
+			&source.Kind{Type: &{{ .GetAPIGroup }}{{ .GetAPIVersion }}.{{ .GetAPIKind }}{}},
+			handler.EnqueueRequestsFromMapFunc(r.dependencyToDependents),
+			builder.WithPredicates(utils.ComponentStatusPredicates()),
+		).
+		{{- end }}
 		Build(r)
 	if err != nil {
 		return err
 	}
 
 	r.Controller = baseController
+	r.Recorder = mgr.GetEventRecorderFor("{{ .Resource.Kind | lower }}-controller")
+
+	// watch the child resources with the watch registry which is shared by all controllers
+	return utils.WatchChildren(mgr, r)
+}
+{{ if .IsComponent }}
+// collectionToComponents maps a collection to the requests of the {{ .Resource.Kind }} components
+// which reference it, so that components are reconciled when their collection changes.
+func (r *{{ .Resource.Kind }}Reconciler) collectionToComponents(collection client.Object) []reconcile.Request {
+	var components {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}List
+
+	if err := r.List(context.Background(), &components); err != nil {
+		r.Log.Error(err, "unable to list {{ .Resource.Kind }} components of collection", "collection", client.ObjectKeyFromObject(collection))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range components.Items {
+		component := &components.Items[i]
+
+		if helpers.CollectionIsReferenced(component.Spec.CollectionRef, component.Namespace, collection) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(component)})
+		}
+	}
+
+	return requests
+}
+
+// SetCollections gets and stores the collection of the component, either by reference or as the
+// only collection of its kind in the cluster.
+{{- if .Ancestors }}  The collections above it are then
+// fetched up to the root collection by following the collection reference of each collection.
+{{- end }}
+func (r *{{ .Resource.Kind }}Reconciler) SetCollections() error {
+	collection := &{{ .Collection.Spec.API.Group }}{{ .Collection.Spec.API.Version }}.{{ .Collection.Spec.API.Kind }}{}
+
+	if err := helpers.GetCollection(r, r.Component.Spec.CollectionRef, r.Component.Namespace, collection); err != nil {
+		return err
+	}
+	{{- $child := "collection" }}
+	{{- range .Ancestors }}
+
+	{{ .GetVarName }} := &{{ .Spec.API.Group }}{{ .Spec.API.Version }}.{{ .Spec.API.Kind }}{}
+
+	if err := helpers.GetCollection(r, {{ $child }}.Spec.CollectionRef, {{ $child }}.Namespace, {{ .GetVarName }}); err != nil {
+		return err
+	}
+	{{- $child = .GetVarName }}
+	{{- end }}
+
+	r.Collection = collection
+	{{- range .Ancestors }}
+	r.{{ .Spec.API.Kind }} = {{ .GetVarName }}
+	{{- end }}
 
 	return nil
 }
+{{ end -}}
+{{ if .Ancestors }}
+// ancestorToComponents maps a collection above the collection of the {{ .Resource.Kind }} components
+// to the requests of all of the components, so that components are reconciled when the collections
+// against which their fields resolve change.
+func (r *{{ .Resource.Kind }}Reconciler) ancestorToComponents(ancestor client.Object) []reconcile.Request {
+	var components {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}List
+
+	if err := r.List(context.Background(), &components); err != nil {
+		r.Log.Error(err, "unable to list {{ .Resource.Kind }} components of collection", "collection", client.ObjectKeyFromObject(ancestor))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range components.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&components.Items[i])})
+	}
+
+	return requests
+}
+{{ end -}}
+{{ if .Dependencies }}
+// dependencyToDependents maps a dependency to the requests of the {{ .Resource.Kind }} components,
+// so that waiting components proceed as soon as the dependency is ready and components whose
+// dependencies were satisfied wait again once the dependency is no longer ready.
+func (r *{{ .Resource.Kind }}Reconciler) dependencyToDependents(dependency client.Object) []reconcile.Request {
+	var components {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}List
+
+	if err := r.List(context.Background(), &components); err != nil {
+		r.Log.Error(err, "unable to list {{ .Resource.Kind }} dependents of dependency", "dependency", client.ObjectKeyFromObject(dependency))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range components.Items {
+		requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&components.Items[i])})
+	}
+
+	return requests
+}
+{{ end -}}
+{{ if .Children }}
+// SetComponentsStatus summarizes the status of the components of the collection on the status
+// of the collection.
+func (r *{{ .Resource.Kind }}Reconciler) SetComponentsStatus() error {
+	lists := []client.ObjectList{
+		{{- range .Children }}
+		&{{ .GetAPIGroup }}{{ .GetAPIVersion }}.{{ .GetAPIKind }}List{},
+		{{- end }}
+	}
+
+	components := []common.Component{}
+
+	for _, list := range lists {
+		if err := r.List(r.Context, list); err != nil {
+			return err
+		}
+
+		items, err := meta.ExtractList(list)
+		if err != nil {
+			return err
+		}
+
+		for _, item := range items {
+			component, ok := item.(common.CollectionComponent)
+			if !ok {
+				continue
+			}
+
+			if helpers.CollectionIsReferenced(component.GetCollectionRef(), item.(client.Object).GetNamespace(), r.Component) {
+				components = append(components, component)
+			}
+		}
+	}
+
+	r.Component.Status.Components = common.NewComponentsStatus(components)
+
+	return nil
+}
+
+// componentToCollections maps a component to the requests of the {{ .Resource.Kind }} collections
+// which it may reference, so that the status of the components is summarized on the collection.
+func (r *{{ .Resource.Kind }}Reconciler) componentToCollections(object client.Object) []reconcile.Request {
+	component, ok := object.(common.CollectionComponent)
+	if !ok {
+		return nil
+	}
+
+	var collections {{ .Resource.ImportAlias }}.{{ .Resource.Kind }}List
+
+	if err := r.List(context.Background(), &collections); err != nil {
+		r.Log.Error(err, "unable to list {{ .Resource.Kind }} collections of component", "component", client.ObjectKeyFromObject(object))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range collections.Items {
+		collection := &collections.Items[i]
+
+		if helpers.CollectionIsReferenced(component.GetCollectionRef(), object.GetNamespace(), collection) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(collection)})
+		}
+	}
+
+	return requests
+}
+{{ end -}}
 `
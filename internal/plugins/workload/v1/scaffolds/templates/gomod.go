@@ -16,6 +16,10 @@ type GoMod struct {
 
 	ControllerRuntimeVersion string
 	CobraVersion             string
+	OpenTelemetryVersion     string
+
+	// Tracing determines whether the OpenTelemetry modules are required.
+	Tracing bool
 }
 
 func (f *GoMod) SetTemplateDefaults() error {
@@ -38,5 +42,11 @@ go 1.15
 require (
 	sigs.k8s.io/controller-runtime {{ .ControllerRuntimeVersion }}
 	github.com/spf13/cobra {{ .CobraVersion }}
+	{{- if .Tracing }}
+	go.opentelemetry.io/otel {{ .OpenTelemetryVersion }}
+	go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc {{ .OpenTelemetryVersion }}
+	go.opentelemetry.io/otel/sdk {{ .OpenTelemetryVersion }}
+	go.opentelemetry.io/otel/trace {{ .OpenTelemetryVersion }}
+	{{- end }}
 )
 `
@@ -31,6 +31,7 @@ const completeTemplate = `{{ .Boilerplate }}
 package phases
 
 import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"{{ .Repo }}/apis/common"
@@ -46,6 +47,8 @@ func (phase *CompletePhase) Execute(
 	r common.ComponentReconciler,
 ) (proceedToNextPhase bool, err error) {
 	r.GetComponent().SetReadyStatus(true)
+	r.GetComponent().SetReadyCondition(metav1.ConditionTrue, common.ReasonReconciled, "successfully reconciled")
+	setComponentReady(r, true)
 	r.GetLogger().V(0).Info("successfully reconciled")
 
 	return true, nil
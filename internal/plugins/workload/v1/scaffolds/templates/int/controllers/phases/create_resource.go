@@ -34,10 +34,12 @@ package phases
 
 import (
 	"fmt"
+	"sort"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
 )
 
 // CreateResourcesPhase.DefaultRequeue executes checking for a parent components readiness status.
@@ -56,21 +58,55 @@ func createResourcePhases() []ResourcePhase {
 	}
 }
 
+// CreateResourcesPhase.Progress returns the wave of resources which is currently being waited for.
+func (phase *CreateResourcesPhase) Progress() string {
+	return phase.progress
+}
+
 // CreateResourcesPhase.Execute executes executes sub-phases which are required to create the resources.
 func (phase *CreateResourcesPhase) Execute(
 	r common.ComponentReconciler,
 ) (proceedToNextPhase bool, err error) {
+	phase.progress = ""
+
+	// create the resources in order of their waves, preserving the order of the resources within a wave
+	desiredResources := make([]common.ComponentResource, len(r.GetResources()))
+	copy(desiredResources, r.GetResources())
+
+	sort.SliceStable(desiredResources, func(i, j int) bool {
+		return desiredResources[i].GetWave() < desiredResources[j].GetWave()
+	})
+
 	// execute the resource phases against each resource
-	for _, resource := range r.GetResources() {
+	var waveStart int
+
+	for i, resource := range desiredResources {
+		// the resources of a wave are only created once the resources of the previous wave are ready
+		if resource.GetWave() != desiredResources[waveStart].GetWave() {
+			ready, err := phase.waveIsReady(resource.GetWave(), desiredResources[waveStart:i])
+			if err != nil || !ready {
+				return false, err
+			}
+
+			waveStart = i
+		}
+
 		resourceCommon := resource.ToCommonResource()
-		resourceCondition := &common.ResourceCondition{}
+		resourceCondition := &common.ResourceCondition{Wave: resource.GetWave()}
 
 		for _, resourcePhase := range createResourcePhases() {
 			r.GetLogger().V(7).Info(fmt.Sprintf("enter resource phase: %T", resourcePhase))
+			endSpan := startResourcePhaseSpan(r, resource, resourcePhase)
 			_, proceed, err := resourcePhase.Execute(resource, *resourceCondition)
+			endSpan(err)
 
 			// set a message, return the error and result on error or when unable to proceed
 			if err != nil || !proceed {
+				if resources.IsApplyConflict(err) {
+					phase.progress = fmt.Sprintf("resource has conflicts with other field managers; kind: [%s], name: [%s], namespace: [%s]",
+						resource.GetKind(), resource.GetName(), resource.GetNamespace())
+				}
+
 				return handleResourcePhaseExit(r, *resourceCommon, *resourceCondition, resourcePhase, proceed, err)
 			}
 
@@ -83,4 +119,24 @@ func (phase *CreateResourcesPhase) Execute(
 
 	return true, nil
 }
+
+// waveIsReady determines if the resources of a wave are ready before the next wave is created.  The
+// first resource which is not ready is reported in the progress of the phase.
+func (phase *CreateResourcesPhase) waveIsReady(nextWave int, wave []common.ComponentResource) (bool, error) {
+	for _, resource := range wave {
+		ready, err := resource.IsReady()
+		if err != nil {
+			return false, err
+		}
+
+		if !ready {
+			phase.progress = fmt.Sprintf("waiting for wave %d to be ready before creating wave %d; kind: [%s], name: [%s], namespace: [%s]",
+				resource.GetWave(), nextWave, resource.GetKind(), resource.GetName(), resource.GetNamespace())
+
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
 `
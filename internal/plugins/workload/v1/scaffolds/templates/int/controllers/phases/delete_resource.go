@@ -0,0 +1,147 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &DeleteResource{}
+
+// DeleteResource scaffolds the delete resource phase methods.
+type DeleteResource struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+}
+
+func (f *DeleteResource) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "delete_resource.go")
+
+	f.TemplateBody = deleteResourceTemplate
+
+	return nil
+}
+
+const deleteResourceTemplate = `{{ .Boilerplate }}
+
+package phases
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
+)
+
+// DeleteResourcesPhase.DefaultRequeue executes checking for the deletion of child resources.
+func (phase *DeleteResourcesPhase) DefaultRequeue() ctrl.Result {
+	return ctrl.Result{
+		Requeue:      true,
+		RequeueAfter: 5 * time.Second,
+	}
+}
+
+// DeleteResourcesPhase.Progress returns the child resource which is currently being deleted.
+func (phase *DeleteResourcesPhase) Progress() string {
+	return phase.progress
+}
+
+// DeleteResourcesPhase.Execute executes the deletion of the child resources of a component.  Child resources
+// are deleted one at a time from the last wave to the first and, within a wave, in the reverse order in which
+// they were created, so that resources are deleted prior to the resources which they depend upon (e.g. workloads
+// before their custom resource definitions).  Child resources which are not owned by the component or which
+// have opted out of pruning are left in the cluster.
+func (phase *DeleteResourcesPhase) Execute(
+	r common.ComponentReconciler,
+) (proceedToNextPhase bool, err error) {
+	component := r.GetComponent().(client.Object)
+
+	for _, resource := range deletionOrder(r.GetComponent().GetResources()) {
+		object := &unstructured.Unstructured{}
+		object.SetGroupVersionKind(schema.GroupVersionKind{
+			Group:   resource.Group,
+			Version: resource.Version,
+			Kind:    resource.Kind,
+		})
+
+		if err := r.Get(
+			r.GetContext(),
+			types.NamespacedName{Name: resource.Name, Namespace: resource.Namespace},
+			object,
+		); err != nil {
+			// the resource has already been deleted
+			if errors.IsNotFound(err) {
+				continue
+			}
+
+			return false, err
+		}
+
+		if !resources.IsOwnedBy(object, r.GetComponent().GetComponentGVK().Kind, component) || object.GetAnnotations()[PruneAnnotation] == "false" {
+			r.GetLogger().V(0).Info(fmt.Sprintf("leaving resource which is not owned or not pruned; kind: [%s], name: [%s], namespace: [%s]",
+				resource.Kind, resource.Name, resource.Namespace))
+
+			continue
+		}
+
+		// run the custom delete logic which may choose to leave the resource in the cluster
+		meta := metav1.Object(object)
+
+		skip, err := r.PreDelete(&meta)
+		if err != nil {
+			return false, err
+		}
+
+		if skip {
+			continue
+		}
+
+		if object.GetDeletionTimestamp().IsZero() {
+			r.GetLogger().V(0).Info(fmt.Sprintf("deleting resource; kind: [%s], name: [%s], namespace: [%s]",
+				resource.Kind, resource.Name, resource.Namespace))
+
+			if err := r.Delete(r.GetContext(), object); err != nil && !errors.IsNotFound(err) {
+				return false, err
+			}
+		}
+
+		// wait for the resource to be removed before deleting the next resource
+		phase.progress = fmt.Sprintf("waiting for deletion of resource; kind: [%s], name: [%s], namespace: [%s]",
+			resource.Kind, resource.Name, resource.Namespace)
+
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// deletionOrder returns the resources in the order in which they are deleted, which is the
+// descending order of their waves and, within a wave, the reverse order in which they were created.
+func deletionOrder(statusResources []common.Resource) []common.Resource {
+	ordered := make([]common.Resource, 0, len(statusResources))
+
+	for i := len(statusResources) - 1; i >= 0; i-- {
+		ordered = append(ordered, statusResources[i])
+	}
+
+	sort.SliceStable(ordered, func(i, j int) bool {
+		return ordered[i].Wave > ordered[j].Wave
+	})
+
+	return ordered
+}
+`
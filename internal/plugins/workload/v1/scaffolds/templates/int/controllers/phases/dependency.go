@@ -32,8 +32,12 @@ package phases
 
 import (
 	"fmt"
+	"time"
 
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,85 +46,233 @@ import (
 	"{{ .Repo }}/internal/helpers"
 )
 
-// DependencyPhase.DefaultRequeue executes checking for a parent components readiness status.
+// DependencyPhase.DefaultRequeue executes checking for a parent components readiness status.  Components
+// are reconciled as soon as their collection or dependencies change, so the requeue is only a fallback.
 func (phase *DependencyPhase) DefaultRequeue() ctrl.Result {
-	return Requeue()
+	return ctrl.Result{
+		Requeue:      true,
+		RequeueAfter: 30 * time.Second,
+	}
+}
+
+// DependencyPhase.Progress returns the collection or dependency which the phase is waiting for.
+func (phase *DependencyPhase) Progress() string {
+	return phase.progress
 }
 
 // DependencyPhase.Execute executes a dependency check prior to attempting to create resources.
 func (phase *DependencyPhase) Execute(r common.ComponentReconciler) (proceedToNextPhase bool, err error) {
 	// dependencies
 	component := r.GetComponent()
+	phase.progress = ""
 
 	if !collectionConfigIsReady(r) {
+		phase.progress = "waiting for the collection of the component"
+		setDependencyWait(r, true)
+
 		return false, nil
 	}
 
-	// TODO: set DependenciesSatisfied field (see next TODO below)
-	if !component.GetDependencyStatus() {
-		satisfied, err := dependenciesSatisfied(r)
-		if err != nil || !satisfied {
-			return false, err
-		}
+	// dependencies are checked on every reconciliation, so that a component waits again when
+	// its dependencies change or a dependency is no longer ready; the dependency status is
+	// persisted along with the status of the phase
+	unsatisfied, err := unsatisfiedDependency(r)
+	if err != nil {
+		setDependencyWait(r, false)
+
+		return false, err
+	}
+
+	if unsatisfied != "" {
+		phase.progress = unsatisfied
+		component.SetDependencyStatus(false)
+		setDependencyWait(r, true)
 
-		// dependencies satisfied; set and update status and continue
-		// TODO: needs implemented
+		return false, nil
 	}
 
+	component.SetDependencyStatus(true)
+	setDependencyWait(r, false)
+
 	return true, nil
 }
 
-// dependenciesSatisfied will return whether or not all dependencies are satisfied for a component.
-func dependenciesSatisfied(r common.ComponentReconciler) (bool, error) {
+// unsatisfiedDependency returns why the first dependency of a component which is not satisfied
+// is unsatisfied.  An empty string is returned when all dependencies are satisfied.
+func unsatisfiedDependency(r common.ComponentReconciler) (string, error) {
 	for _, dep := range r.GetComponent().GetDependencies() {
-		satisfied, err := dependencySatisfied(r, dep)
-		if err != nil || !satisfied {
-			return false, err
+		unsatisfied, err := dependencySatisfied(r, dep)
+		if err != nil || unsatisfied != "" {
+			return unsatisfied, err
 		}
 	}
 
-	return true, nil
+	return "", nil
 }
 
-// dependencySatisfied will return whether or not an individual dependency is satisfied.
-func dependencySatisfied(r common.ComponentReconciler, dependency common.Component) (bool, error) {
-	// get the dependencies by kind that already exist in cluster
-	dependencyList := &unstructured.UnstructuredList{}
+// dependencySatisfied returns why an individual dependency is not satisfied.  An empty string
+// is returned when the dependency is satisfied.
+func dependencySatisfied(r common.ComponentReconciler, dependency common.Component) (string, error) {
+	gvk := dependency.GetComponentGVK()
 
-	dependencyList.SetGroupVersionKind(dependency.GetComponentGVK())
+	object, unsatisfied, err := getDependency(r, gvk)
+	if err != nil || unsatisfied != "" {
+		return unsatisfied, err
+	}
 
-	if err := r.List(r.GetContext(), dependencyList, &client.ListOptions{}); err != nil {
-		return false, err
+	// get the status.created field on the object and return the status and any errors found
+	status, found, err := unstructured.NestedBool(object.Object, "status", "created")
+	if err != nil {
+		return "", err
 	}
 
-	// expect only one item returned, otherwise dependencies are considered unsatisfied
-	if len(dependencyList.Items) != 1 {
-		return false, nil
+	if !found || !status {
+		return fmt.Sprintf("waiting for dependency %s %s to be ready", gvk.Kind, dependencyName(object.GetNamespace(), object.GetName())), nil
 	}
 
-	// get the status.created field on the object and return the status and any errors found
-	status, found, err := unstructured.NestedBool(dependencyList.Items[0].Object, "status", "created")
+	return "", nil
+}
+
+// getDependency returns the custom resource of a dependency of a component, or why it cannot be
+// determined.  The dependency is either referenced by the component or is the only custom resource
+// of its kind, preferring those which belong to the collection of the component and then those in
+// the namespace of the component.  Dependencies of other collections are found when none belong to
+// the collection of the component, as components may depend on those of other collections.
+func getDependency(r common.ComponentReconciler, gvk schema.GroupVersionKind) (*unstructured.Unstructured, string, error) {
+	component := r.GetComponent().(client.Object)
+
+	if dependent, ok := r.GetComponent().(common.DependentComponent); ok {
+		for _, ref := range dependent.GetDependencyRefs() {
+			if ref.Kind == gvk.Kind {
+				return getDependencyByRef(r, gvk, ref)
+			}
+		}
+	}
+
+	dependencies := &unstructured.UnstructuredList{}
+	dependencies.SetGroupVersionKind(gvk)
+
+	if err := r.List(r.GetContext(), dependencies, &client.ListOptions{}); err != nil {
+		return nil, "", err
+	}
+
+	candidates := make([]*unstructured.Unstructured, len(dependencies.Items))
+	for i := range dependencies.Items {
+		candidates[i] = &dependencies.Items[i]
+	}
+
+	candidates = preferDependencies(candidates, func(dependency *unstructured.Unstructured) bool {
+		return helpers.CollectionIsReferenced(getCollectionRef(dependency), dependency.GetNamespace(), r.GetCollection())
+	})
+
+	candidates = preferDependencies(candidates, func(dependency *unstructured.Unstructured) bool {
+		return dependency.GetNamespace() == component.GetNamespace()
+	})
+
+	switch len(candidates) {
+	case 0:
+		return nil, fmt.Sprintf("waiting for dependency %s to be created", gvk.Kind), nil
+	case 1:
+		return candidates[0], "", nil
+	default:
+		return nil, fmt.Sprintf("multiple dependencies of kind %s found; expected 1 or a dependencyRef", gvk.Kind), nil
+	}
+}
+
+// preferDependencies returns the candidates for a dependency which are preferred, or all of the
+// candidates if none are preferred.
+func preferDependencies(
+	candidates []*unstructured.Unstructured,
+	preferred func(*unstructured.Unstructured) bool,
+) []*unstructured.Unstructured {
+	if len(candidates) < 2 {
+		return candidates
+	}
+
+	matches := []*unstructured.Unstructured{}
+
+	for _, candidate := range candidates {
+		if preferred(candidate) {
+			matches = append(matches, candidate)
+		}
+	}
+
+	if len(matches) == 0 {
+		return candidates
+	}
+
+	return matches
+}
+
+// getDependencyByRef returns the custom resource of a dependency which is referenced by a component.
+func getDependencyByRef(
+	r common.ComponentReconciler,
+	gvk schema.GroupVersionKind,
+	ref common.DependencyReference,
+) (*unstructured.Unstructured, string, error) {
+	key := types.NamespacedName{Name: ref.Name, Namespace: ref.Namespace}
+	if key.Namespace == "" {
+		key.Namespace = r.GetComponent().(client.Object).GetNamespace()
+	}
+
+	clusterScoped, err := helpers.IsClusterScoped(r, gvk)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if clusterScoped {
+		key.Namespace = ""
+	}
+
+	dependency := &unstructured.Unstructured{}
+	dependency.SetGroupVersionKind(gvk)
+
+	if err := r.Get(r.GetContext(), key, dependency); err != nil {
+		if apierrs.IsNotFound(err) {
+			return nil, fmt.Sprintf("waiting for dependency %s %s to be created", gvk.Kind, dependencyName(key.Namespace, key.Name)), nil
+		}
+
+		return nil, "", err
+	}
+
+	return dependency, "", nil
+}
+
+// dependencyName returns the name of a dependency, which is qualified by its namespace unless it is
+// cluster-scoped.
+func dependencyName(namespace, name string) string {
+	if namespace == "" {
+		return name
+	}
+
+	return namespace + "/" + name
+}
+
+// getCollectionRef returns the reference to the collection of a component, if any.
+func getCollectionRef(component *unstructured.Unstructured) *common.CollectionReference {
+	ref, found, err := unstructured.NestedStringMap(component.Object, "spec", "collectionRef")
 	if err != nil || !found {
-		return false, err
+		return nil
 	}
 
-	return status, nil
+	return &common.CollectionReference{Name: ref["name"], Namespace: ref["namespace"]}
 }
 
-// collectionConfigIsReady determines if a component's collection is ready.
+// collectionConfigIsReady determines if a component's collection is ready.  The collection is
+// resolved by the reconciler, either by the collectionRef of the component or as the only
+// collection in the cluster.
 func collectionConfigIsReady(r common.ComponentReconciler) bool {
-	// get a list of configurations from the cluster
-	collectionConfigs, err := helpers.GetCollectionConfigs(r)
-	if err != nil {
+	collection := r.GetCollection()
+	if collection == nil {
 		r.GetLogger().V(0).Info("unable to find resource of kind: [" + helpers.CollectionAPIKind + "]")
 
 		return false
 	}
 
-	// configuration is not ready if we do not have exactly one configuration
-	if len(collectionConfigs.Items) != 1 {
+	// the collection is not ready while it is being deleted
+	if !collection.GetDeletionTimestamp().IsZero() {
 		r.GetLogger().V(0).Info(
-			fmt.Sprintf("expected only 1 resource of kind: [%s]; found %v", helpers.CollectionAPIKind, len(collectionConfigs.Items)),
+			fmt.Sprintf("resource of kind: [%s], name: [%s] is being deleted", helpers.CollectionAPIKind, collection.GetName()),
 		)
 
 		return false
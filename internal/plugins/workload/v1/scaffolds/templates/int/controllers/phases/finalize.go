@@ -0,0 +1,68 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Finalize{}
+
+// Finalize scaffolds the finalize phase methods.
+type Finalize struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+	machinery.DomainMixin
+}
+
+func (f *Finalize) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "finalize.go")
+
+	f.TemplateBody = finalizeTemplate
+
+	return nil
+}
+
+const finalizeTemplate = `{{ .Boilerplate }}
+
+package phases
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"{{ .Repo }}/apis/common"
+)
+
+// FinalizerName is the finalizer which ensures that the child resources of a component
+// are deleted before the component itself is deleted.
+const FinalizerName = "{{ .Domain }}/finalizer"
+
+// FinalizePhase.DefaultRequeue executes checking for a parent components readiness status.
+func (phase *FinalizePhase) DefaultRequeue() ctrl.Result {
+	return Requeue()
+}
+
+// FinalizePhase.Execute executes the removal of the finalizer once the child resources of a
+// component have been deleted, which allows the deletion of the component to complete.
+func (phase *FinalizePhase) Execute(
+	r common.ComponentReconciler,
+) (proceedToNextPhase bool, err error) {
+	component := r.GetComponent().(client.Object)
+
+	deleteComponentMetrics(r)
+
+	if !controllerutil.ContainsFinalizer(component, FinalizerName) {
+		return true, nil
+	}
+
+	controllerutil.RemoveFinalizer(component, FinalizerName)
+
+	return true, r.Update(r.GetContext(), component)
+}
+`
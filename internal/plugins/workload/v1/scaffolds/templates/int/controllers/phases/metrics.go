@@ -0,0 +1,141 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Metrics{}
+
+// Metrics scaffolds the phase metrics.
+type Metrics struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+}
+
+func (f *Metrics) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "metrics.go")
+
+	f.TemplateBody = metricsTemplate
+
+	return nil
+}
+
+const metricsTemplate = `{{ .Boilerplate }}
+
+package phases
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"{{ .Repo }}/apis/common"
+)
+
+var (
+	// phaseDuration measures the duration of the execution of a phase.
+	phaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "reconciler_phase_duration_seconds",
+			Help: "Duration of the execution of a reconciliation phase in seconds.",
+		},
+		[]string{"kind", "phase"},
+	)
+
+	// phaseTotal counts the outcomes of the execution of a phase.
+	phaseTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "reconciler_phase_total",
+			Help: "Number of executions of a reconciliation phase by outcome.",
+		},
+		[]string{"kind", "phase", "outcome"},
+	)
+
+	// dependencyWait indicates whether a component is waiting for its dependencies.
+	dependencyWait = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "reconciler_dependency_wait",
+			Help: "Whether a component is waiting for its dependencies (1) or not (0).",
+		},
+		[]string{"kind", "name", "namespace"},
+	)
+
+	// componentReady indicates whether a component is ready.
+	componentReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "reconciler_component_ready",
+			Help: "Whether a component is ready (1) or not (0).",
+		},
+		[]string{"kind", "name", "namespace"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		phaseDuration,
+		phaseTotal,
+		dependencyWait,
+		componentReady,
+	)
+}
+
+// ObservePhaseDuration records the duration of the execution of a phase.
+func ObservePhaseDuration(r common.ComponentReconciler, phase Phase, duration time.Duration) {
+	phaseDuration.WithLabelValues(
+		r.GetComponent().GetComponentGVK().Kind,
+		getPhaseName(phase),
+	).Observe(duration.Seconds())
+}
+
+// countPhaseOutcome counts the outcome of the execution of a phase using the state of
+// its condition.
+func countPhaseOutcome(r common.ComponentReconciler, condition *common.PhaseCondition) {
+	phaseTotal.WithLabelValues(
+		r.GetComponent().GetComponentGVK().Kind,
+		condition.Phase,
+		string(condition.State),
+	).Inc()
+}
+
+// setDependencyWait records whether a component is waiting for its dependencies.
+func setDependencyWait(r common.ComponentReconciler, waiting bool) {
+	dependencyWait.WithLabelValues(componentLabelValues(r)...).Set(boolToFloat(waiting))
+}
+
+// setComponentReady records whether a component is ready.
+func setComponentReady(r common.ComponentReconciler, ready bool) {
+	componentReady.WithLabelValues(componentLabelValues(r)...).Set(boolToFloat(ready))
+}
+
+// deleteComponentMetrics removes the metrics of a component once it has been deleted.
+func deleteComponentMetrics(r common.ComponentReconciler) {
+	dependencyWait.DeleteLabelValues(componentLabelValues(r)...)
+	componentReady.DeleteLabelValues(componentLabelValues(r)...)
+}
+
+func componentLabelValues(r common.ComponentReconciler) []string {
+	component := r.GetComponent().(client.Object)
+
+	return []string{
+		r.GetComponent().GetComponentGVK().Kind,
+		component.GetName(),
+		component.GetNamespace(),
+	}
+}
+
+func boolToFloat(value bool) float64 {
+	if value {
+		return 1
+	}
+
+	return 0
+}
+`
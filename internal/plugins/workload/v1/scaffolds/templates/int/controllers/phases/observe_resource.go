@@ -0,0 +1,140 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &ObserveResource{}
+
+// ObserveResource scaffolds the observe resource phase methods.
+type ObserveResource struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+}
+
+func (f *ObserveResource) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "observe_resource.go")
+
+	f.TemplateBody = observeResourceTemplate
+
+	return nil
+}
+
+const observeResourceTemplate = `{{ .Boilerplate }}
+
+package phases
+
+import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
+)
+
+// ObserveResourcesPhase.DefaultRequeue executes checking for a parent components readiness status.
+func (phase *ObserveResourcesPhase) DefaultRequeue() ctrl.Result {
+	return Requeue()
+}
+
+// ObserveResourcesPhase.Execute compares the child resources of a component which is observed only
+// with their desired state and reports the drift in the status of the component, without creating
+// or updating the child resources.
+func (phase *ObserveResourcesPhase) Execute(
+	r common.ComponentReconciler,
+) (proceedToNextPhase bool, err error) {
+	var drifted int
+
+	for _, resource := range r.GetResources() {
+		resourceDrifted, err := observeResource(r, resource.(*resources.Resource))
+		if err != nil {
+			return false, err
+		}
+
+		if resourceDrifted {
+			drifted++
+		}
+	}
+
+	condition := metav1.Condition{
+		Type:    common.ConditionTypeDrifted,
+		Status:  metav1.ConditionFalse,
+		Reason:  common.ReasonNoDrift,
+		Message: "child resources match their desired state",
+	}
+
+	if drifted > 0 {
+		condition.Status = metav1.ConditionTrue
+		condition.Reason = common.ReasonDriftDetected
+		condition.Message = fmt.Sprintf("%d of %d child resources differ from their desired state", drifted, len(r.GetResources()))
+	}
+
+	r.GetComponent().SetCondition(condition)
+	r.GetLogger().V(0).Info("observed child resources; " + condition.Message)
+
+	return true, nil
+}
+
+// observeResource determines if a child resource differs from its desired state and reports the
+// drift on the condition of the resource.
+func observeResource(
+	r common.ComponentReconciler,
+	resource *resources.Resource,
+) (drifted bool, err error) {
+	commonResource := resource.ToCommonResource()
+
+	// keep the condition of a resource which was previously created
+	condition := common.ResourceCondition{Wave: resource.GetWave()}
+	if found := commonResource.GetResourceIndex(r.GetComponent()); found >= 0 {
+		condition = r.GetComponent().GetResources()[found].ResourceCondition
+	}
+
+	actual := &unstructured.Unstructured{}
+	actual.SetGroupVersionKind(resource.Object.GetObjectKind().GroupVersionKind())
+
+	if err := r.Get(r.GetContext(), client.ObjectKeyFromObject(resource.Object), actual); err != nil {
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		condition.Drifted = true
+		condition.Message = "resource does not exist and would be created"
+	} else {
+		actualResource := resources.NewResourceFromClient(actual, r)
+
+		needsUpdate, err := resources.NeedsUpdate(*resource, *actualResource)
+		if err != nil {
+			return false, err
+		}
+
+		condition.Drifted = needsUpdate
+		condition.Message = "resource matches its desired state"
+
+		if needsUpdate {
+			paths, err := resources.ChangedPaths(*resource, *actualResource)
+			if err != nil {
+				return false, err
+			}
+
+			condition.Message = "resource differs from its desired state at paths [" + resources.SummarizePaths(paths) +
+				"] and would be updated"
+		}
+	}
+
+	commonResource.ResourceCondition = condition
+	r.GetComponent().SetResource(*commonResource)
+
+	return condition.Drifted, nil
+}
+`
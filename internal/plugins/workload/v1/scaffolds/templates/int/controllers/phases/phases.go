@@ -33,10 +33,16 @@ package phases
 import (
 	"fmt"
 	"strings"
+	"time"
 
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
 )
 
 const optimisticLockErrorMsg = "the object has been modified; please apply your changes to the latest version and try again"
@@ -72,12 +78,66 @@ func updateResourceConditions(
 	resource common.Resource,
 	condition *common.ResourceCondition,
 ) error {
+	// keep the drift and recreation of the resource, which are recorded when the resource is updated
+	if found := resource.GetResourceIndex(r.GetComponent()); found >= 0 {
+		existing := r.GetComponent().GetResources()[found].ResourceCondition
+
+		if condition.DriftCorrections == 0 {
+			condition.LastDriftTime = existing.LastDriftTime
+			condition.DriftCorrections = existing.DriftCorrections
+			condition.DriftedPaths = existing.DriftedPaths
+		}
+
+		if condition.LastRecreateTime == "" {
+			condition.LastRecreateTime = existing.LastRecreateTime
+			condition.RecreateReason = existing.RecreateReason
+		}
+	}
+
 	resource.ResourceCondition = *condition
 	r.GetComponent().SetResource(resource)
 
 	return r.UpdateStatus()
 }
 
+// setNotReadyCondition sets the ready condition of the parent custom resource to false using the
+// condition of a phase which has not completed.  The reason is derived from the phase and its
+// state, e.g. DependencyPhasePending.
+func setNotReadyCondition(
+	r common.ComponentReconciler,
+	condition *common.PhaseCondition,
+) {
+	r.GetComponent().SetReadyCondition(metav1.ConditionFalse, condition.Phase+string(condition.State), condition.Message)
+	setComponentReady(r, false)
+}
+
+// recordPhaseEvent records an event on the parent custom resource when the state or message of a
+// phase changes.  Events are not recorded for phases which remain in the same state, so that an
+// event is not recorded on every reconciliation.
+func recordPhaseEvent(
+	r common.ComponentReconciler,
+	condition *common.PhaseCondition,
+) {
+	if found := condition.GetPhaseConditionIndex(r.GetComponent()); found >= 0 {
+		previous := r.GetComponent().GetPhaseConditions()[found]
+		if previous.State == condition.State && previous.Message == condition.Message {
+			return
+		}
+	}
+
+	eventType := corev1.EventTypeNormal
+	if condition.State == common.PhaseStateFailed {
+		eventType = corev1.EventTypeWarning
+	}
+
+	r.GetEventRecorder().Event(
+		r.GetComponent().(client.Object),
+		eventType,
+		condition.Phase+string(condition.State),
+		condition.Message,
+	)
+}
+
 // HandlePhaseExit will perform the steps required to exit a phase.
 func HandlePhaseExit(
 	reconciler common.ComponentReconciler,
@@ -96,20 +156,26 @@ func HandlePhaseExit(
 			condition = GetSuccessCondition(phase)
 		} else {
 			condition = GetFailCondition(phase, phaseError)
+			setNotReadyCondition(reconciler, &condition)
 		}
 		result = DefaultReconcileResult()
 	case !phaseIsReady:
 		condition = GetPendingCondition(phase)
+		setNotReadyCondition(reconciler, &condition)
 		result = phase.DefaultRequeue()
 	default:
 		condition = GetSuccessCondition(phase)
 		result = DefaultReconcileResult()
 	}
 
-	// update the status conditions and return any errors
+	recordPhaseEvent(reconciler, &condition)
+	countPhaseOutcome(reconciler, &condition)
+
+	// update the status conditions and return any errors, ignoring components which
+	// no longer exist once their finalizer has been removed
 	if updateError := updatePhaseConditions(reconciler, &condition); updateError != nil {
 		// adjust the message if we had both an update error and a phase error
-		if !IsOptimisticLockError(updateError) {
+		if !IsOptimisticLockError(updateError) && !errors.IsNotFound(updateError) {
 			if phaseError != nil {
 				phaseError = fmt.Errorf("failed to update status conditions; %v; %v", updateError, phaseError)
 			} else {
@@ -132,6 +198,15 @@ func handleResourcePhaseExit(
 ) (bool, error) {
 
 	switch {
+	case resources.IsApplyConflict(phaseError):
+		// a conflict which is not forced leaves the resource, and therefore the component,
+		// not ready rather than failing the phase
+		condition.LastResourcePhase = getResourcePhaseName(phase)
+		condition.LastModified = time.Now().UTC().String()
+		condition.Message = "resource has conflicts with other field managers"
+		condition.Conflict = phaseError.Error()
+		phaseError = nil
+		phaseIsReady = false
 	case phaseError != nil:
 		if IsOptimisticLockError(phaseError) {
 			phaseError = nil
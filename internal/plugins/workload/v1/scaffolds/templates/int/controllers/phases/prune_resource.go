@@ -0,0 +1,268 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &PruneResource{}
+
+// PruneResource scaffolds the prune resource phase methods.
+type PruneResource struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+	machinery.DomainMixin
+}
+
+func (f *PruneResource) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "prune_resource.go")
+
+	f.TemplateBody = pruneResourceTemplate
+
+	return nil
+}
+
+const pruneResourceTemplate = `{{ .Boilerplate }}
+
+package phases
+
+import (
+	"fmt"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
+	"{{ .Repo }}/internal/scope"
+)
+
+const (
+	// PruneAnnotation is the annotation which, when set to "false" on a child resource,
+	// leaves the child resource in the cluster once it is no longer desired.
+	PruneAnnotation = "{{ .Domain }}/prune"
+
+	// PruneDryRunAnnotation is the annotation which, when set to "true" on a component,
+	// only reports the child resources which would be pruned rather than deleting them.
+	PruneDryRunAnnotation = "{{ .Domain }}/prune-dry-run"
+)
+
+// PruneResourcesPhase.DefaultRequeue executes checking for a parent components readiness status.
+func (phase *PruneResourcesPhase) DefaultRequeue() ctrl.Result {
+	return Requeue()
+}
+
+// PruneResourcesPhase.Execute executes the deletion of child resources which were previously created
+// for a component but are no longer desired, e.g. due to a change of the component spec.
+func (phase *PruneResourcesPhase) Execute(
+	r common.ComponentReconciler,
+) (proceedToNextPhase bool, err error) {
+	component := r.GetComponent().(client.Object)
+	dryRun := component.GetAnnotations()[PruneDryRunAnnotation] == "true"
+
+	candidates, err := pruneCandidates(r)
+	if err != nil {
+		return false, err
+	}
+
+	var modified bool
+
+	for _, candidate := range candidates {
+		resource := candidate.resource
+
+		if isDesiredResource(r, resource) {
+			continue
+		}
+
+		object := candidate.object
+		if object == nil {
+			object = &unstructured.Unstructured{}
+			object.SetGroupVersionKind(resourceGVK(resource))
+
+			if err := r.Get(
+				r.GetContext(),
+				types.NamespacedName{Name: resource.Name, Namespace: resource.Namespace},
+				object,
+			); err != nil {
+				if !errors.IsNotFound(err) {
+					return false, err
+				}
+
+				// the resource no longer exists and only needs to be removed from the status
+				r.GetComponent().RemoveResource(resource)
+				modified = true
+
+				continue
+			}
+		}
+
+		// only prune resources which are owned by the component and have not
+		// opted out of pruning
+		if !resources.IsOwnedBy(object, r.GetComponent().GetComponentGVK().Kind, component) || object.GetAnnotations()[PruneAnnotation] == "false" {
+			r.GetLogger().V(0).Info(fmt.Sprintf("leaving resource which is no longer desired; kind: [%s], name: [%s], namespace: [%s]",
+				resource.Kind, resource.Name, resource.Namespace))
+
+			r.GetComponent().RemoveResource(resource)
+			modified = true
+
+			continue
+		}
+
+		if dryRun {
+			r.GetLogger().V(0).Info(fmt.Sprintf("resource would be pruned; kind: [%s], name: [%s], namespace: [%s]",
+				resource.Kind, resource.Name, resource.Namespace))
+
+			if message := "resource is no longer desired and would be pruned"; resource.Message != message {
+				resource.Message = message
+				r.GetComponent().SetResource(resource)
+				modified = true
+			}
+
+			continue
+		}
+
+		r.GetLogger().V(0).Info(fmt.Sprintf("pruning resource; kind: [%s], name: [%s], namespace: [%s]",
+			resource.Kind, resource.Name, resource.Namespace))
+
+		if err := r.Delete(r.GetContext(), object); err != nil && !errors.IsNotFound(err) {
+			return false, err
+		}
+
+		r.GetComponent().RemoveResource(resource)
+		modified = true
+	}
+
+	if modified {
+		return true, r.UpdateStatus()
+	}
+
+	return true, nil
+}
+
+// pruneCandidate is a child resource which may be pruned, along with its object in the cluster
+// when the child resource was found by listing the kinds which the component produces.
+type pruneCandidate struct {
+	resource common.Resource
+	object   *unstructured.Unstructured
+}
+
+// pruneCandidates returns the child resources of a component which may be pruned.  These are found
+// by listing the kinds which the component produces, i.e. the kinds of its desired resources and
+// of the resources in its status, for the resources which are owned by the component by their
+// controller reference or parent labels.  The resources in the status of the component which are
+// not found by listing, e.g. as they no longer exist, supplement the listed resources so that they
+// are removed from the status.
+func pruneCandidates(r common.ComponentReconciler) ([]pruneCandidate, error) {
+	component := r.GetComponent().(client.Object)
+	kind := r.GetComponent().GetComponentGVK().Kind
+
+	// copy the resources as stale resources are removed from the component status
+	statusResources := append([]common.Resource{}, r.GetComponent().GetResources()...)
+
+	kinds := map[schema.GroupVersionKind]bool{}
+
+	for _, desired := range r.GetResources() {
+		kinds[desired.GetObject().GetObjectKind().GroupVersionKind()] = true
+	}
+
+	for _, resource := range statusResources {
+		kinds[resourceGVK(resource)] = true
+	}
+
+	namespaces := scope.Namespaces()
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	listed := map[common.ResourceCommon]*unstructured.Unstructured{}
+
+	for gvk := range kinds {
+		for _, namespace := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+			if err := r.List(r.GetContext(), list, client.InNamespace(namespace)); err != nil {
+				// the kind no longer exists, e.g. as its custom resource definition was deleted
+				if meta.IsNoMatchError(err) {
+					break
+				}
+
+				return nil, err
+			}
+
+			for i := range list.Items {
+				object := &list.Items[i]
+
+				if !resources.IsOwnedBy(object, kind, component) {
+					continue
+				}
+
+				listed[common.ResourceCommon{
+					Group:     gvk.Group,
+					Version:   gvk.Version,
+					Kind:      gvk.Kind,
+					Name:      object.GetName(),
+					Namespace: object.GetNamespace(),
+				}] = object
+			}
+		}
+	}
+
+	candidates := []pruneCandidate{}
+
+	// the resources in the status keep their message and order
+	for _, resource := range statusResources {
+		candidates = append(candidates, pruneCandidate{resource: resource, object: listed[resource.ResourceCommon]})
+		delete(listed, resource.ResourceCommon)
+	}
+
+	// the other owned resources are not in the status, e.g. as the status was not persisted
+	others := []pruneCandidate{}
+	for resource, object := range listed {
+		others = append(others, pruneCandidate{resource: common.Resource{ResourceCommon: resource}, object: object})
+	}
+
+	sort.Slice(others, func(i, j int) bool {
+		return fmt.Sprintf("%v", others[i].resource.ResourceCommon) < fmt.Sprintf("%v", others[j].resource.ResourceCommon)
+	})
+
+	return append(candidates, others...), nil
+}
+
+// resourceGVK returns the group, version and kind of a resource which is listed in the status of a
+// component.
+func resourceGVK(resource common.Resource) schema.GroupVersionKind {
+	return schema.GroupVersionKind{
+		Group:   resource.Group,
+		Version: resource.Version,
+		Kind:    resource.Kind,
+	}
+}
+
+// isDesiredResource determines if a resource which is listed in the status of a component
+// is one of the desired resources of the reconciler.
+func isDesiredResource(r common.ComponentReconciler, resource common.Resource) bool {
+	for _, desired := range r.GetResources() {
+		if desired.GetGroup() == resource.Group &&
+			desired.GetVersion() == resource.Version &&
+			desired.GetKind() == resource.Kind &&
+			desired.GetName() == resource.Name &&
+			desired.GetNamespace() == resource.Namespace {
+			return true
+		}
+	}
+
+	return false
+}
+`
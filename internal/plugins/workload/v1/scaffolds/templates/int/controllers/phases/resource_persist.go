@@ -33,9 +33,12 @@ package phases
 import (
 	"time"
 
+	corev1 "k8s.io/api/core/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/resources"
 )
 
 // PersistResourcePhase.Execute executes persisting resources to the Kubernetes database.
@@ -66,8 +69,22 @@ func persistResource(
 	if err := r.CreateOrUpdate(resource.GetObject()); err != nil {
 		if IsOptimisticLockError(err) {
 			return nil
+		} else if resources.IsApplyConflict(err) {
+			// the conflict is reported on the resource condition and the resource is
+			// not ready until the conflict is resolved; see handleResourcePhaseExit
+			r.GetLogger().V(0).Info(err.Error())
+
+			// only record an event when the conflict differs from the previously reported conflict
+			commonResource := resource.ToCommonResource()
+			if found := commonResource.GetResourceIndex(r.GetComponent()); found < 0 ||
+				r.GetComponent().GetResources()[found].Conflict != err.Error() {
+				r.GetEventRecorder().Event(r.GetComponent().(client.Object), corev1.EventTypeWarning, "ApplyConflict", err.Error())
+			}
+
+			return err
 		} else {
 			r.GetLogger().V(0).Info(err.Error())
+			r.GetEventRecorder().Event(r.GetComponent().(client.Object), corev1.EventTypeWarning, "PersistFailed", err.Error())
 
 			return err
 		}
@@ -16,6 +16,8 @@ type ResourceWait struct {
 	machinery.TemplateMixin
 	machinery.BoilerplateMixin
 	machinery.RepositoryMixin
+
+	NamespaceScoped bool
 }
 
 func (f *ResourceWait) SetTemplateDefaults() error {
@@ -35,7 +37,9 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"{{ .Repo }}/apis/common"
+	{{- if not .NamespaceScoped }}
 	"{{ .Repo }}/internal/resources"
+	{{- end }}
 )
 
 // WaitForResourcePhase.Execute executes waiting for a resource to be ready before continuing.
@@ -70,6 +74,16 @@ func (phase *WaitForResourcePhase) Execute(
 	return ctrl.Result{}, true, nil
 }
 
+{{ if .NamespaceScoped -}}
+// commonWait applies all common waiting functions for known resources.  The namespaces which
+// are watched by a namespace-scoped operator are not checked, as namespaces are cluster-scoped.
+func commonWait(
+	r common.ComponentReconciler,
+	resource common.ComponentResource,
+) (bool, error) {
+	return true, nil
+}
+{{- else -}}
 // TODO: the following allows all controllers to list all namespaces,
 // regardless of whether or not the controller manages namespaces.
 //
@@ -96,4 +110,5 @@ func commonWait(
 
 	return true, nil
 }
+{{- end }}
 `
@@ -0,0 +1,116 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package phases
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Tracing{}
+
+// Tracing scaffolds the phase tracing methods.
+type Tracing struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+
+	// Enabled determines whether spans are recorded.  The methods do nothing when
+	// tracing is not enabled, so that the phases do not depend on OpenTelemetry.
+	Enabled bool
+}
+
+func (f *Tracing) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "phases", "tracing.go")
+
+	f.TemplateBody = tracingTemplate
+
+	return nil
+}
+
+const tracingTemplate = `{{ .Boilerplate }}
+
+package phases
+
+{{ if .Enabled -}}
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/tracing"
+)
+
+// StartPhaseSpan starts a span for the execution of a phase against a component and returns
+// the function which ends the span.
+func StartPhaseSpan(r common.ComponentReconciler, phase Phase) func(error) {
+	component := r.GetComponent().(client.Object)
+
+	return startSpan(r, getPhaseName(phase), tracing.ObjectAttributes(
+		r.GetComponent().GetComponentGVK(),
+		component.GetName(),
+		component.GetNamespace(),
+	)...)
+}
+
+// startResourcePhaseSpan starts a span for the execution of a resource phase against a child
+// resource and returns the function which ends the span.
+func startResourcePhaseSpan(
+	r common.ComponentReconciler,
+	resource common.ComponentResource,
+	resourcePhase ResourcePhase,
+) func(error) {
+	gvk := schema.GroupVersionKind{
+		Group:   resource.GetGroup(),
+		Version: resource.GetVersion(),
+		Kind:    resource.GetKind(),
+	}
+
+	return startSpan(r, getResourcePhaseName(resourcePhase), tracing.ObjectAttributes(
+		gvk,
+		resource.GetName(),
+		resource.GetNamespace(),
+	)...)
+}
+
+// startSpan starts a span as a child of the span in the context of the reconciler.  The context
+// of the reconciler is replaced so that spans which are started in the meantime become children of
+// the span.  The returned function ends the span and restores the context of the reconciler.
+func startSpan(r common.ComponentReconciler, name string, attributes ...attribute.KeyValue) func(error) {
+	parent := r.GetContext()
+
+	ctx, span := tracing.Start(parent, name, attributes...)
+	r.SetContext(ctx)
+
+	return func(err error) {
+		tracing.End(span, err)
+		r.SetContext(parent)
+	}
+}
+{{- else -}}
+import (
+	"{{ .Repo }}/apis/common"
+)
+
+// StartPhaseSpan returns the function which ends the span of a phase.  Tracing is not enabled, so
+// no span is started.
+func StartPhaseSpan(r common.ComponentReconciler, phase Phase) func(error) {
+	return endNoSpan
+}
+
+// startResourcePhaseSpan returns the function which ends the span of a resource phase.  Tracing
+// is not enabled, so no span is started.
+func startResourcePhaseSpan(
+	r common.ComponentReconciler,
+	resource common.ComponentResource,
+	resourcePhase ResourcePhase,
+) func(error) {
+	return endNoSpan
+}
+
+func endNoSpan(error) {}
+{{- end }}
+`
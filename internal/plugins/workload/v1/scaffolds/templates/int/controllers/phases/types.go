@@ -46,17 +46,27 @@ type Phase interface {
 	DefaultRequeue() ctrl.Result
 }
 
+// PhaseProgress defines a phase which reports its progress in the message of its
+// pending condition.
+type PhaseProgress interface {
+	Progress() string
+}
+
 // ResourcePhase defines the specific phase of reconcilication associated with creating resources.
 type ResourcePhase interface {
 	Execute(common.ComponentResource, common.ResourceCondition) (ctrl.Result, bool, error)
 }
 
 // Below are the phase types which satisfy the Phase interface.
-type DependencyPhase struct{}
+type DependencyPhase struct{ progress string }
 type PreFlightPhase struct{}
-type CreateResourcesPhase struct{}
+type CreateResourcesPhase struct{ progress string }
+type PruneResourcesPhase struct{}
+type ObserveResourcesPhase struct{}
 type CheckReadyPhase struct{}
 type CompletePhase struct{}
+type DeleteResourcesPhase struct{ progress string }
+type FinalizePhase struct{}
 
 // Below are the phase types which satisfy the ResourcePhase interface.
 type PersistResourcePhase struct{}
@@ -73,11 +83,17 @@ func GetSuccessCondition(phase Phase) common.PhaseCondition {
 
 // GetPendingCondition defines the pending condition for the phase.
 func GetPendingCondition(phase Phase) common.PhaseCondition {
-	return common.PhaseCondition{
+	condition := common.PhaseCondition{
 		Phase:   getPhaseName(phase),
 		State:   common.PhaseStatePending,
 		Message: "Pending Execution of Phase",
 	}
+
+	if progress, ok := phase.(PhaseProgress); ok && progress.Progress() != "" {
+		condition.Message = condition.Message + "; " + progress.Progress()
+	}
+
+	return condition
 }
 
 // GetFailCondition defines the fail condition for the phase.
@@ -17,6 +17,7 @@ type Utils struct {
 	machinery.BoilerplateMixin
 	machinery.RepositoryMixin
 	machinery.ResourceMixin
+	machinery.DomainMixin
 
 	IsStandalone bool
 }
@@ -35,24 +36,36 @@ const controllerUtilsTemplate = `{{ .Boilerplate }}
 package utils
 
 import (
+	"fmt"
 	"reflect"
+	"time"
 
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/event"
-	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
-	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/helpers"
 	"{{ .Repo }}/internal/resources"
 	controllerphases "{{ .Repo }}/internal/controllers/phases"
 )
 
 const (
 	FieldManager = "reconciler"
+
+	// PauseAnnotation is the annotation which, when set to "true" on a component, skips
+	// all phases of its reconciliation, e.g. during an incident.
+	PauseAnnotation = "{{ .Domain }}/pause"
+
+	// ObserveOnlyAnnotation is the annotation which, when set to "true" on a component,
+	// reports the drift of its child resources from their desired state rather than
+	// creating or updating them.
+	ObserveOnlyAnnotation = "{{ .Domain }}/observe-only"
 )
 
 func IgnoreNotFound(err error) error {
@@ -69,6 +82,7 @@ func CreatePhases() []controllerphases.Phase {
 		&controllerphases.DependencyPhase{},
 		&controllerphases.PreFlightPhase{},
 		&controllerphases.CreateResourcesPhase{},
+		&controllerphases.PruneResourcesPhase{},
 		&controllerphases.CheckReadyPhase{},
 		&controllerphases.CompletePhase{},
 	}
@@ -80,18 +94,142 @@ func UpdatePhases() []controllerphases.Phase {
 	return CreatePhases()
 }
 
+// DeletePhases defines the phases for delete and the order in which they run during the reconcile process.
+func DeletePhases() []controllerphases.Phase {
+	return []controllerphases.Phase{
+		&controllerphases.DeleteResourcesPhase{},
+		&controllerphases.FinalizePhase{},
+	}
+}
+
+// ObservePhases defines the phases for a component which is observed only and the order in which
+// they run during the reconcile process.
+func ObservePhases() []controllerphases.Phase {
+	return []controllerphases.Phase{
+		&controllerphases.ObserveResourcesPhase{},
+	}
+}
+
 // Phases returns which phases to run given the component.
 func Phases(component common.Component) []controllerphases.Phase {
 	var phases []controllerphases.Phase
-	if !component.GetReadyStatus() {
+
+	switch {
+	case !component.GetDeletionTimestamp().IsZero():
+		phases = DeletePhases()
+	case IsObserveOnly(component):
+		phases = ObservePhases()
+	case !component.GetReadyStatus():
 		phases = CreatePhases()
-	} else {
+	default:
 		phases = UpdatePhases()
 	}
 
 	return phases
 }
 
+// ExecutePhases executes the phases against a component and returns early if a phase
+// is not ready or returns an error.
+func ExecutePhases(
+	r common.ComponentReconciler,
+	phases []controllerphases.Phase,
+) (ctrl.Result, error) {
+	for _, phase := range phases {
+		r.GetLogger().V(7).Info(fmt.Sprintf("enter phase: %T", phase))
+		endSpan := controllerphases.StartPhaseSpan(r, phase)
+		start := time.Now()
+		proceed, err := phase.Execute(r)
+		controllerphases.ObservePhaseDuration(r, phase, time.Since(start))
+		endSpan(err)
+		result, err := controllerphases.HandlePhaseExit(r, phase, proceed, err)
+
+		// return only if we have an error or are told not to proceed
+		if err != nil || !proceed {
+			r.GetLogger().V(2).Info(fmt.Sprintf("not ready; requeuing phase: %T", phase))
+
+			return result, err
+		}
+
+		r.GetLogger().V(5).Info(fmt.Sprintf("completed phase: %T", phase))
+	}
+
+	return controllerphases.DefaultReconcileResult(), nil
+}
+
+// IsPaused returns whether the reconciliation of a component is paused by its pause annotation.
+func IsPaused(component common.Component) bool {
+	return component.(client.Object).GetAnnotations()[PauseAnnotation] == "true"
+}
+
+// IsObserveOnly returns whether a component is observed only by its observe-only annotation.
+func IsObserveOnly(component common.Component) bool {
+	return component.(client.Object).GetAnnotations()[ObserveOnlyAnnotation] == "true"
+}
+
+// SetModeConditions sets the paused condition of a component from its pause annotation and
+// removes the drifted condition of a component which is no longer observed only, so that the
+// conditions do not go stale.  The status is only updated when the conditions change.
+func SetModeConditions(r common.ComponentReconciler) error {
+	component := r.GetComponent()
+	previous := append([]metav1.Condition{}, component.GetConditions()...)
+
+	if IsPaused(component) {
+		component.SetCondition(metav1.Condition{
+			Type:    common.ConditionTypePaused,
+			Status:  metav1.ConditionTrue,
+			Reason:  common.ReasonPaused,
+			Message: "reconciliation is paused by the " + PauseAnnotation + " annotation; deletion is not paused",
+		})
+	} else {
+		component.RemoveCondition(common.ConditionTypePaused)
+	}
+
+	if !IsObserveOnly(component) {
+		component.RemoveCondition(common.ConditionTypeDrifted)
+	}
+
+	if reflect.DeepEqual(previous, component.GetConditions()) {
+		return nil
+	}
+
+	return r.UpdateStatus()
+}
+
+// RegisterFinalizer adds the finalizer to a component which is not being deleted, so
+// that its child resources are deleted by the delete phases prior to the deletion of
+// the component.
+func RegisterFinalizer(r common.ComponentReconciler) error {
+	component := r.GetComponent().(client.Object)
+
+	if !component.GetDeletionTimestamp().IsZero() || controllerutil.ContainsFinalizer(component, controllerphases.FinalizerName) {
+		return nil
+	}
+
+	controllerutil.AddFinalizer(component, controllerphases.FinalizerName)
+
+	return r.Update(r.GetContext(), component)
+}
+
+// SetOwner sets the component of a reconciler as the owner of a child resource, by a controller
+// reference or, for a cluster-scoped child resource of a namespaced component, by the parent
+// labels.
+func SetOwner(r common.ComponentReconciler, resource metav1.Object) error {
+	component := r.GetComponent().(client.Object)
+
+	if component.GetNamespace() != "" {
+		clusterScoped, err := helpers.IsClusterScoped(r, resource.(client.Object).GetObjectKind().GroupVersionKind())
+		if err != nil {
+			return err
+		}
+
+		if clusterScoped {
+			return resources.SetParentLabels(resource, r.GetComponent().GetComponentGVK().Kind, component)
+		}
+	}
+
+	return ctrl.SetControllerReference(component, resource, r.GetScheme())
+}
+
 // getDesiredObject returns the desired object from a list stored on the
 // reconciler.
 func getDesiredObject(compared *resources.Resource) (desired *resources.Resource) {
@@ -119,6 +257,11 @@ func needsReconciliation(existing, requested resources.Resource) bool {
 		}
 	}
 
+	// server-side apply calculates the differences to the resource on the server
+	if requested.GetPersistenceStrategy() == resources.PersistenceStrategyApply {
+		return true
+	}
+
 	// get the desired object from the reconciler and ensure that we both
 	// found that desired object and that the desired object fields are equal
 	// to the existing object fields
@@ -164,7 +307,7 @@ func ResourcePredicates(r common.ComponentReconciler) predicate.Predicate {
 func ComponentPredicates() predicate.Predicate {
 	return predicate.Funcs{
 		UpdateFunc: func(e event.UpdateEvent) bool {
-			return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration()
+			return e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() || modeChanged(e.ObjectOld, e.ObjectNew)
 		},
 		CreateFunc: func(e event.CreateEvent) bool {
 			return true
@@ -178,41 +321,46 @@ func ComponentPredicates() predicate.Predicate {
 	}
 }
 
-// Watch watches a resource.
-func Watch(
-	r common.ComponentReconciler,
-	resource client.Object,
-) error {
-	// check if the resource is already being watched
-	var watched bool
-
-	if len(r.GetWatches()) > 0 {
-		for _, watcher := range r.GetWatches() {
-			if reflect.DeepEqual(
-				resource.GetObjectKind().GroupVersionKind(),
-				watcher.GetObjectKind().GroupVersionKind(),
-			) {
-				watched = true
-			}
+// modeChanged determines if the pause or observe-only annotations of an object have changed, which
+// do not change the generation of the object.
+func modeChanged(oldObject, newObject client.Object) bool {
+	for _, annotation := range []string{PauseAnnotation, ObserveOnlyAnnotation} {
+		if oldObject.GetAnnotations()[annotation] != newObject.GetAnnotations()[annotation] {
+			return true
 		}
 	}
 
-	// watch the resource if it current is not being watched
-	if !watched {
-		if err := r.GetController().Watch(
-			&source.Kind{Type: resource},
-			&handler.EnqueueRequestForOwner{
-				IsController: true,
-				OwnerType:    r.GetComponent().(runtime.Object),
-			},
-			ResourcePredicates(r),
-		); err != nil {
-			return err
-		}
+	return false
+}
 
-		r.SetWatch(resource)
-	}
+// ComponentStatusPredicates returns the predicates for components which are watched by other
+// controllers, such as the components of a collection or the dependencies of a component.  Only
+// the updates which change the summarized status or the spec of a component are allowed.
+func ComponentStatusPredicates() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld.GetGeneration() != e.ObjectNew.GetGeneration() {
+				return true
+			}
 
-	return nil
+			oldComponent, oldOK := e.ObjectOld.(common.Component)
+			newComponent, newOK := e.ObjectNew.(common.Component)
+
+			if !oldOK || !newOK {
+				return true
+			}
+
+			return common.NewComponentStatus(oldComponent) != common.NewComponentStatus(newComponent)
+		},
+		CreateFunc: func(e event.CreateEvent) bool {
+			return true
+		},
+		DeleteFunc: func(e event.DeleteEvent) bool {
+			return true
+		},
+		GenericFunc: func(e event.GenericEvent) bool {
+			return false
+		},
+	}
 }
 `
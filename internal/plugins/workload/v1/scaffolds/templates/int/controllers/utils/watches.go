@@ -0,0 +1,543 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package utils
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Watches{}
+
+// Watches scaffolds the registry of the watches of the child resources of all controllers.
+type Watches struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+}
+
+func (f *Watches) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "controllers", "utils", "watches.go")
+
+	f.TemplateBody = controllerWatchesTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+//nolint: lll
+const controllerWatchesTemplate = `{{ .Boilerplate }}
+
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"{{ .Repo }}/apis/common"
+	"{{ .Repo }}/internal/helpers"
+	"{{ .Repo }}/internal/resources"
+	"{{ .Repo }}/internal/scope"
+)
+
+// WatchesPath is the path of the debug endpoint, served by the metrics server, which lists the
+// kinds of child resources which are watched and the components which produce them.
+const WatchesPath = "/debug/watches"
+
+var (
+	watchRegistry     *WatchRegistry
+	watchRegistryErr  error
+	watchRegistryOnce sync.Once
+)
+
+// WatchRegistry watches each kind of child resource once for all controllers and reconciles, and
+// stops watching a kind once no component produces child resources of the kind.
+type WatchRegistry struct {
+	lock    sync.Mutex
+	ctx     context.Context
+	client  dynamic.Interface
+	mapper  meta.RESTMapper
+	watches map[schema.GroupVersionKind]*kindWatch
+	sources map[string]*childSource
+}
+
+// kindWatch is the watch of a kind of child resource, with an informer for each namespace which is
+// watched, along with the components, by the name of their controller, which produce child
+// resources of the kind.
+type kindWatch struct {
+	informers []cache.SharedIndexInformer
+	cancel    context.CancelFunc
+	producers map[string]map[types.NamespacedName]bool
+}
+
+// childSource is the source of the events of the child resources of a controller.
+type childSource struct {
+	lock       sync.Mutex
+	handler    handler.EventHandler
+	queue      workqueue.RateLimitingInterface
+	predicates []predicate.Predicate
+}
+
+// WatchedKind is a kind of child resource which is watched, along with the components which
+// produce child resources of the kind, by the name of their controller.
+type WatchedKind struct {
+	Group       string              ` + "`" + `json:"group"` + "`" + `
+	Version     string              ` + "`" + `json:"version"` + "`" + `
+	Kind        string              ` + "`" + `json:"kind"` + "`" + `
+	Controllers map[string][]string ` + "`" + `json:"controllers"` + "`" + `
+}
+
+// getWatchRegistry returns the watch registry of the manager, which is created and added to the
+// manager along with its debug endpoint upon the setup of the first controller.
+func getWatchRegistry(mgr ctrl.Manager) (*WatchRegistry, error) {
+	watchRegistryOnce.Do(func() {
+		dynamicClient, err := dynamic.NewForConfig(mgr.GetConfig())
+		if err != nil {
+			watchRegistryErr = err
+
+			return
+		}
+
+		registry := &WatchRegistry{
+			client:  dynamicClient,
+			mapper:  mgr.GetRESTMapper(),
+			watches: map[schema.GroupVersionKind]*kindWatch{},
+			sources: map[string]*childSource{},
+		}
+
+		if err := mgr.Add(registry); err != nil {
+			watchRegistryErr = err
+
+			return
+		}
+
+		if err := mgr.AddMetricsExtraHandler(WatchesPath, registry); err != nil {
+			watchRegistryErr = err
+
+			return
+		}
+
+		watchRegistry = registry
+	})
+
+	return watchRegistry, watchRegistryErr
+}
+
+// WatchChildren registers the child resources of the components of a reconciler as a source of
+// the controller of the reconciler.  The child resources are mapped to their parent by their
+// controller reference or, for cluster-scoped child resources of namespaced components, by their
+// parent labels.
+func WatchChildren(mgr ctrl.Manager, r common.ComponentReconciler) error {
+	registry, err := getWatchRegistry(mgr)
+	if err != nil {
+		return fmt.Errorf("unable to create watch registry; %w", err)
+	}
+
+	parentGVK := r.GetComponent().GetComponentGVK()
+
+	parentClusterScoped, err := helpers.IsClusterScoped(r, parentGVK)
+	if err != nil {
+		return err
+	}
+
+	toParent := func(object client.Object) []reconcile.Request {
+		parent, ok := resources.ParentOf(object, parentGVK, parentClusterScoped)
+		if !ok {
+			return nil
+		}
+
+		request := reconcile.Request{NamespacedName: parent}
+
+		return []reconcile.Request{request}
+	}
+
+	return r.GetController().Watch(
+		registry.source(controllerName(r)),
+		handler.EnqueueRequestsFromMapFunc(toParent),
+		ResourcePredicates(r),
+	)
+}
+
+// Watch watches the kinds of the desired child resources of the component of a reconciler and
+// stops watching the kinds which the component no longer produces.  It returns whether all of the
+// kinds are watched, as a kind which is not yet known to the API server is not watched.
+func Watch(r common.ComponentReconciler) (bool, error) {
+	if watchRegistry == nil {
+		return true, nil
+	}
+
+	kinds := map[schema.GroupVersionKind]bool{}
+	for _, resource := range r.GetResources() {
+		kinds[resource.GetObject().GetObjectKind().GroupVersionKind()] = true
+	}
+
+	return watchRegistry.setProduced(
+		controllerName(r),
+		client.ObjectKeyFromObject(r.GetComponent().(client.Object)),
+		kinds,
+	)
+}
+
+// Unwatch stops watching the kinds of child resources which are produced only by a component
+// which no longer exists.
+func Unwatch(r common.ComponentReconciler, parent types.NamespacedName) {
+	if watchRegistry == nil {
+		return
+	}
+
+	// removing all kinds of a component never starts a watch and may not fail
+	_, _ = watchRegistry.setProduced(controllerName(r), parent, nil)
+}
+
+// controllerName returns the name of the controller of a reconciler within the watch registry.
+func controllerName(r common.ComponentReconciler) string {
+	return r.GetComponent().GetComponentGVK().GroupKind().String()
+}
+
+// Start starts the watches which were requested before the manager was started and stops all
+// watches when the manager is stopped.
+func (registry *WatchRegistry) Start(ctx context.Context) error {
+	registry.lock.Lock()
+	registry.ctx = ctx
+
+	for _, watch := range registry.watches {
+		registry.run(watch)
+	}
+	registry.lock.Unlock()
+
+	<-ctx.Done()
+
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	for gvk, watch := range registry.watches {
+		watch.cancel()
+		delete(registry.watches, gvk)
+	}
+
+	return nil
+}
+
+// ServeHTTP serves the kinds of child resources which are watched as JSON.
+func (registry *WatchRegistry) ServeHTTP(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+
+	if err := json.NewEncoder(w).Encode(registry.Watched()); err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+	}
+}
+
+// Watched returns the kinds of child resources which are watched, sorted by their group, version
+// and kind.
+func (registry *WatchRegistry) Watched() []WatchedKind {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	watched := []WatchedKind{}
+
+	for gvk, watch := range registry.watches {
+		kind := WatchedKind{
+			Group:       gvk.Group,
+			Version:     gvk.Version,
+			Kind:        gvk.Kind,
+			Controllers: map[string][]string{},
+		}
+
+		for name, parents := range watch.producers {
+			for parent := range parents {
+				kind.Controllers[name] = append(kind.Controllers[name], parent.String())
+			}
+
+			sort.Strings(kind.Controllers[name])
+		}
+
+		watched = append(watched, kind)
+	}
+
+	sort.Slice(watched, func(i, j int) bool {
+		return fmt.Sprintf("%s/%s/%s", watched[i].Group, watched[i].Version, watched[i].Kind) <
+			fmt.Sprintf("%s/%s/%s", watched[j].Group, watched[j].Version, watched[j].Kind)
+	})
+
+	return watched
+}
+
+// source returns the source of the events of the child resources of a controller.
+func (registry *WatchRegistry) source(name string) *childSource {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	if _, ok := registry.sources[name]; !ok {
+		registry.sources[name] = &childSource{}
+	}
+
+	return registry.sources[name]
+}
+
+// setProduced sets the kinds of child resources which a component produces.  A kind is watched
+// when it is first produced and is no longer watched when no component produces it.  A kind which
+// is not yet known to the API server, e.g. the kind of a custom resource whose definition is a
+// child resource of the same component, is not watched until a later reconciliation finds it.  It
+// returns whether all of the kinds are watched.
+func (registry *WatchRegistry) setProduced(
+	name string,
+	parent types.NamespacedName,
+	kinds map[schema.GroupVersionKind]bool,
+) (bool, error) {
+	registry.lock.Lock()
+	defer registry.lock.Unlock()
+
+	watched := true
+
+	for gvk := range kinds {
+		watch, ok := registry.watches[gvk]
+		if !ok {
+			var err error
+
+			if watch, err = registry.newWatch(gvk); err != nil {
+				if meta.IsNoMatchError(err) {
+					watched = false
+
+					continue
+				}
+
+				return false, fmt.Errorf("unable to watch %s; %w", gvk, err)
+			}
+
+			registry.watches[gvk] = watch
+			registry.run(watch)
+		}
+
+		if watch.producers[name] == nil {
+			watch.producers[name] = map[types.NamespacedName]bool{}
+		}
+
+		watch.producers[name][parent] = true
+	}
+
+	for gvk, watch := range registry.watches {
+		if kinds[gvk] {
+			continue
+		}
+
+		delete(watch.producers[name], parent)
+
+		if len(watch.producers[name]) == 0 {
+			delete(watch.producers, name)
+		}
+
+		if len(watch.producers) == 0 {
+			if watch.cancel != nil {
+				watch.cancel()
+			}
+
+			delete(registry.watches, gvk)
+		}
+	}
+
+	return watched, nil
+}
+
+// newWatch creates the informers of a kind of child resource, which dispatch the events of the
+// child resources to the sources of the controllers which produce the kind.  A single informer
+// watches all namespaces unless the operator only watches specific namespaces.
+func (registry *WatchRegistry) newWatch(gvk schema.GroupVersionKind) (*kindWatch, error) {
+	mapping, err := registry.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaces := scope.Namespaces()
+	if len(namespaces) == 0 {
+		namespaces = []string{""}
+	}
+
+	watch := &kindWatch{producers: map[string]map[types.NamespacedName]bool{}}
+
+	for _, namespace := range namespaces {
+		informer := dynamicinformer.NewFilteredDynamicInformer(
+			registry.client,
+			mapping.Resource,
+			namespace,
+			0,
+			cache.Indexers{},
+			nil,
+		).Informer()
+
+		informer.AddEventHandler(registry.eventHandler(watch))
+
+		watch.informers = append(watch.informers, informer)
+	}
+
+	return watch, nil
+}
+
+// eventHandler returns the handler of the events of the informers of a watch.
+func (registry *WatchRegistry) eventHandler(watch *kindWatch) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(object interface{}) {
+			if child, ok := object.(client.Object); ok {
+				registry.dispatch(watch, func(source *childSource) {
+					source.create(event.CreateEvent{Object: child})
+				})
+			}
+		},
+		UpdateFunc: func(oldObject, newObject interface{}) {
+			oldChild, oldOK := oldObject.(client.Object)
+			newChild, newOK := newObject.(client.Object)
+
+			if oldOK && newOK {
+				registry.dispatch(watch, func(source *childSource) {
+					source.update(event.UpdateEvent{ObjectOld: oldChild, ObjectNew: newChild})
+				})
+			}
+		},
+		DeleteFunc: func(object interface{}) {
+			deleteEvent := event.DeleteEvent{}
+
+			switch child := object.(type) {
+			case *unstructured.Unstructured:
+				deleteEvent.Object = child
+			case cache.DeletedFinalStateUnknown:
+				deleted, ok := child.Obj.(client.Object)
+				if !ok {
+					return
+				}
+
+				deleteEvent.Object = deleted
+				deleteEvent.DeleteStateUnknown = true
+			default:
+				return
+			}
+
+			registry.dispatch(watch, func(source *childSource) {
+				source.delete(deleteEvent)
+			})
+		},
+	}
+}
+
+// run runs the informers of a watch until it is no longer watched, once the manager is started.
+func (registry *WatchRegistry) run(watch *kindWatch) {
+	if registry.ctx == nil || watch.cancel != nil {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(registry.ctx)
+	watch.cancel = cancel
+
+	for _, informer := range watch.informers {
+		go informer.Run(ctx.Done())
+	}
+}
+
+// dispatch dispatches an event of a child resource to the sources of the controllers which produce
+// the kind of the child resource.
+func (registry *WatchRegistry) dispatch(watch *kindWatch, send func(*childSource)) {
+	registry.lock.Lock()
+
+	sources := []*childSource{}
+
+	for name := range watch.producers {
+		if source, ok := registry.sources[name]; ok {
+			sources = append(sources, source)
+		}
+	}
+	registry.lock.Unlock()
+
+	for _, source := range sources {
+		send(source)
+	}
+}
+
+// Start registers the event handler, queue and predicates of the controller of the source.
+func (source *childSource) Start(
+	_ context.Context,
+	eventHandler handler.EventHandler,
+	queue workqueue.RateLimitingInterface,
+	predicates ...predicate.Predicate,
+) error {
+	source.lock.Lock()
+	defer source.lock.Unlock()
+
+	source.handler = eventHandler
+	source.queue = queue
+	source.predicates = predicates
+
+	return nil
+}
+
+// started returns the event handler, queue and predicates of the source, if it is started.
+func (source *childSource) started() (handler.EventHandler, workqueue.RateLimitingInterface, []predicate.Predicate, bool) {
+	source.lock.Lock()
+	defer source.lock.Unlock()
+
+	return source.handler, source.queue, source.predicates, source.handler != nil
+}
+
+func (source *childSource) create(e event.CreateEvent) {
+	eventHandler, queue, predicates, ok := source.started()
+	if !ok {
+		return
+	}
+
+	for _, p := range predicates {
+		if !p.Create(e) {
+			return
+		}
+	}
+
+	eventHandler.Create(e, queue)
+}
+
+func (source *childSource) update(e event.UpdateEvent) {
+	eventHandler, queue, predicates, ok := source.started()
+	if !ok {
+		return
+	}
+
+	for _, p := range predicates {
+		if !p.Update(e) {
+			return
+		}
+	}
+
+	eventHandler.Update(e, queue)
+}
+
+func (source *childSource) delete(e event.DeleteEvent) {
+	eventHandler, queue, predicates, ok := source.started()
+	if !ok {
+		return
+	}
+
+	for _, p := range predicates {
+		if !p.Delete(e) {
+			return
+		}
+	}
+
+	eventHandler.Delete(e, queue)
+}
+`
@@ -0,0 +1,54 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package delete
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/vmware-tanzu-labs/operator-builder/internal/utils"
+)
+
+var _ machinery.Template = &Component{}
+
+// Component scaffolds the workload's delete function.
+type Component struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+	machinery.ResourceMixin
+}
+
+func (f *Component) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"delete",
+		fmt.Sprintf("%s.go", utils.ToFileName(f.Resource.Kind)),
+	)
+
+	f.TemplateBody = componentTemplate
+
+	return nil
+}
+
+const componentTemplate = `{{ .Boilerplate }}
+
+package delete
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"{{ .Repo }}/apis/common"
+)
+
+// {{ .Resource.Kind }}PreDelete performs the logic prior to deleting resources that belong to the parent.
+// Resources are left in the cluster when skip is returned.
+func {{ .Resource.Kind }}PreDelete(reconciler common.ComponentReconciler,
+	object *metav1.Object,
+) (skip bool, err error) {
+	return false, nil
+}
+`
@@ -35,20 +35,29 @@ import (
 	"errors"
 	"fmt"
 
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 
 	"{{ .Repo }}/apis/common"
 )
 
 const (
-	Domain               = "{{ .Domain }}"
-	CollectionAPIGroup   = "{{ .Resource.Group }}"
-	CollectionAPIVersion = "{{ .Resource.Version }}"
-	CollectionAPIKind    = "{{ .Resource.Kind }}"
+	Domain                  = "{{ .Domain }}"
+	CollectionAPIGroup      = "{{ .Resource.Group }}"
+	CollectionAPIVersion    = "{{ .Resource.Version }}"
+	CollectionAPIKind       = "{{ .Resource.Kind }}"
+)
+
+var (
+	ErrCollectionNotFound  = errors.New("no collections available")
+	ErrCollectionNotUnique = errors.New("multiple collections found; expected 1 or a collectionRef")
 )
 
 // SkipResourceCreation skips the resource creation during the mutate phase.
@@ -70,23 +79,20 @@ func GetProperObject(destination metav1.Object, source metav1.Object) error {
 	return runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredObject, destination)
 }
 
-// getValueFromCollection gets a specific value from the {{ .Resource.Kind }} resource.
+// getValueFromCollection gets a specific value from the {{ .Resource.Kind }} resource of a reconciler.
 func getValueFromCollection(reconciler common.ComponentReconciler, path ...string) (string, error) {
-	// retrieve a list of platform configs
-	collectionConfigs, err := GetCollectionConfigs(reconciler)
-	if err != nil {
-		return "", err
+	collection := reconciler.GetCollection()
+	if collection == nil {
+		return "", ErrCollectionNotFound
 	}
 
-	if len(collectionConfigs.Items) > 1 {
-		return "", errors.New("Must have only one collection resource present in the cluster")
+	collectionConfig, err := runtime.DefaultUnstructuredConverter.ToUnstructured(collection)
+	if err != nil {
+		return "", err
 	}
 
-	// get the first platform config
-	collectionConfig := collectionConfigs.Items[0]
-
 	// get the value from the platform config
-	collectionConfigValue, found, err := unstructured.NestedString(collectionConfig.Object, path...)
+	collectionConfigValue, found, err := unstructured.NestedString(collectionConfig, path...)
 	if !found || err != nil {
 		return "", fmt.Errorf("unable to get path %s from platform configuration; %v\n", path, err)
 	}
@@ -115,6 +121,90 @@ func GetCollectionConfigs(
 	return collectionConfigs, nil
 }
 
+// GetCollection gets the collection which is referenced by a component in a namespace into the
+// destination object, which determines the kind of the collection.  When the component does not
+// reference a collection, the only collection of that kind in the cluster is used.  The
+// collections above a nested collection are fetched in the same manner from the reference of the
+// nested collection.
+func GetCollection(
+	r common.ComponentReconciler,
+	ref *common.CollectionReference,
+	namespace string,
+	destination client.Object,
+) error {
+	gvk, err := apiutil.GVKForObject(destination, r.GetScheme())
+	if err != nil {
+		return err
+	}
+
+	if ref != nil && ref.Name != "" {
+		clusterScoped, err := IsClusterScoped(r, gvk)
+		if err != nil {
+			return err
+		}
+
+		if err := r.Get(r.GetContext(), collectionKey(ref, namespace, clusterScoped), destination); err != nil {
+			if apierrs.IsNotFound(err) {
+				return fmt.Errorf("%w; %v", ErrCollectionNotFound, err)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+
+	collectionConfigs := unstructured.UnstructuredList{}
+	collectionConfigs.SetGroupVersionKind(gvk)
+
+	if err := r.List(r.GetContext(), &collectionConfigs, &client.ListOptions{}); err != nil {
+		return err
+	}
+
+	switch len(collectionConfigs.Items) {
+	case 0:
+		return ErrCollectionNotFound
+	case 1:
+		return runtime.DefaultUnstructuredConverter.FromUnstructured(collectionConfigs.Items[0].Object, destination)
+	default:
+		return ErrCollectionNotUnique
+	}
+}
+
+// IsClusterScoped determines if the resources of a kind are cluster-scoped.
+func IsClusterScoped(r common.ComponentReconciler, gvk schema.GroupVersionKind) (bool, error) {
+	mapping, err := r.GetClient().RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return false, err
+	}
+
+	return mapping.Scope.Name() == meta.RESTScopeNameRoot, nil
+}
+
+// CollectionIsReferenced determines if a collection may be the collection of a component in a
+// namespace.  A component without a reference may use any collection.
+func CollectionIsReferenced(ref *common.CollectionReference, namespace string, collection client.Object) bool {
+	if ref == nil || ref.Name == "" {
+		return true
+	}
+
+	return collectionKey(ref, namespace, collection.GetNamespace() == "") == client.ObjectKeyFromObject(collection)
+}
+
+// collectionKey returns the name and namespace of the collection which is referenced by a
+// component in a namespace.
+func collectionKey(ref *common.CollectionReference, namespace string, clusterScoped bool) types.NamespacedName {
+	if clusterScoped {
+		return types.NamespacedName{Name: ref.Name}
+	}
+
+	if ref.Namespace != "" {
+		namespace = ref.Namespace
+	}
+
+	return types.NamespacedName{Name: ref.Name, Namespace: namespace}
+}
+
 // GetCollectionName returns the name of the platform from the {{ .Resource.Kind }} resource.
 func GetCollectionName(r common.ComponentReconciler) (string, error) {
 	return getValueFromCollection(r, "metadata", "name")
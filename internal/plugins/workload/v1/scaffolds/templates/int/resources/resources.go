@@ -7,6 +7,8 @@ import (
 	"path/filepath"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	workloadv1 "github.com/vmware-tanzu-labs/operator-builder/internal/workload/v1"
 )
 
 var _ machinery.Template = &ResourceType{}
@@ -30,6 +32,38 @@ type DaemonSetType struct{ ResourceType }
 type StatefulSetType struct{ ResourceType }
 type JobType struct{ ResourceType }
 type ServiceType struct{ ResourceType }
+type MetricsType struct{ ResourceType }
+type PersistentVolumeClaimType struct{ ResourceType }
+type IngressType struct{ ResourceType }
+type CronJobType struct{ ResourceType }
+type ReplicaSetType struct{ ResourceType }
+type PodType struct{ ResourceType }
+type APIServiceType struct{ ResourceType }
+type GenericType struct{ ResourceType }
+
+// PersistenceType scaffolds the persistence configuration of the workload's
+// resources package.
+type PersistenceType struct {
+	ResourceType
+
+	Persistence *workloadv1.PersistenceConfig
+}
+
+// ConfigHashType scaffolds the hashing of the ConfigMaps and Secrets which are
+// referenced by the workloads of the workload's resources package.
+type ConfigHashType struct {
+	ResourceType
+	machinery.DomainMixin
+
+	Persistence *workloadv1.PersistenceConfig
+}
+
+// OwnershipType scaffolds the ownership of the child resources of the workload's
+// resources package.
+type OwnershipType struct {
+	ResourceType
+	machinery.DomainMixin
+}
 
 func (f *ResourceType) SetTemplateDefaults() error {
 	f.Path = filepath.Join(
@@ -55,6 +89,141 @@ func (f *Resources) SetTemplateDefaults() error {
 	return nil
 }
 
+func (f *PersistentVolumeClaimType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"persistentvolumeclaim.go",
+	)
+
+	f.TemplateBody = persistentVolumeClaimTemplate
+
+	return nil
+}
+
+func (f *IngressType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"ingress.go",
+	)
+
+	f.TemplateBody = ingressTemplate
+
+	return nil
+}
+
+func (f *CronJobType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"cronjob.go",
+	)
+
+	f.TemplateBody = cronJobTemplate
+
+	return nil
+}
+
+func (f *ReplicaSetType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"replicaset.go",
+	)
+
+	f.TemplateBody = replicaSetTemplate
+
+	return nil
+}
+
+func (f *PodType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"pod.go",
+	)
+
+	f.TemplateBody = podTemplate
+
+	return nil
+}
+
+func (f *APIServiceType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"apiservice.go",
+	)
+
+	f.TemplateBody = apiServiceTemplate
+
+	return nil
+}
+
+func (f *GenericType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"generic.go",
+	)
+
+	f.TemplateBody = genericTemplate
+
+	return nil
+}
+
+func (f *MetricsType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"metrics.go",
+	)
+
+	f.TemplateBody = metricsTemplate
+
+	return nil
+}
+
+func (f *PersistenceType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"persistence.go",
+	)
+
+	f.TemplateBody = persistenceTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+func (f *ConfigHashType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"config_hash.go",
+	)
+
+	f.TemplateBody = configHashTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
+func (f *OwnershipType) SetTemplateDefaults() error {
+	f.Path = filepath.Join(
+		"internal",
+		"resources",
+		"ownership.go",
+	)
+
+	f.TemplateBody = ownershipTemplate
+	f.IfExistsAction = machinery.OverwriteFile
+
+	return nil
+}
+
 func (f *NamespaceType) SetTemplateDefaults() error {
 	f.Path = filepath.Join(
 		"internal",
@@ -168,6 +337,7 @@ const resourceTypesTemplate = `{{ .Boilerplate }}
 package resources
 
 import (
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"{{ .Repo }}/apis/common"
@@ -179,6 +349,17 @@ type Resource struct {
 
 	Object     client.Object
 	Reconciler common.ComponentReconciler
+
+	// ReadyFunc determines the readiness of the resource in place of the readiness of its kind,
+	// as generated from the ready expression of its resource marker.
+	ReadyFunc func(*unstructured.Unstructured) (bool, error)
+
+	// Wave is the wave in which the resource is created.
+	Wave int
+
+	// RecreatePolicy is the recreate policy of the resource from its resource marker, which
+	// overrides the recreate policy of its kind.
+	RecreatePolicy RecreatePolicy
 }
 `
 
@@ -187,13 +368,20 @@ const resourcesTemplate = `{{ .Boilerplate }}
 package resources
 
 import (
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/imdario/mergo"
 	"github.com/banzaicloud/k8s-objectmatcher/patch"
 	"github.com/banzaicloud/operator-tools/pkg/reconciler"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -205,10 +393,18 @@ import (
 
 const (
 	FieldManager = "reconciler"
+
+	// maxDriftedPaths is the maximum number of paths which are listed in the summary of the
+	// paths of a resource which differ from its desired state.
+	maxDriftedPaths = 5
 )
 
 // Create creates a resource.
 func (resource *Resource) Create() error {
+	if resource.GetPersistenceStrategy() == PersistenceStrategyApply {
+		return resource.apply(nil)
+	}
+
 	resource.Reconciler.GetLogger().V(0).Info(fmt.Sprintf("creating resource; kind: [%s], name: [%s], namespace: [%s]",
 		resource.Kind, resource.Name, resource.Namespace))
 
@@ -217,14 +413,23 @@ func (resource *Resource) Create() error {
 		resource.Object,
 		&client.CreateOptions{FieldManager: FieldManager},
 	); err != nil {
-		return fmt.Errorf("unable to create resource; %v", err)
+		return fmt.Errorf("unable to create resource; %w", err)
 	}
 
+	resource.recordEvent("Created", "created resource")
+	resource.countOperation(OperationCreate)
+
 	return nil
 }
 
 // Update updates a resource.
 func (resource *Resource) Update(oldResource *Resource) error {
+	// server-side apply calculates the differences to the resource on the server
+	// so there is no need to determine if the resource needs an update
+	if resource.GetPersistenceStrategy() == PersistenceStrategyApply && resource.Kind != CustomResourceDefinitionKind {
+		return resource.apply(oldResource)
+	}
+
 	needsUpdate, err := NeedsUpdate(*resource, *oldResource)
 	if err != nil {
 		return err
@@ -240,13 +445,165 @@ func (resource *Resource) Update(oldResource *Resource) error {
 			client.Merge,
 			&client.PatchOptions{FieldManager: FieldManager},
 		); err != nil {
+			if IsImmutableFieldError(err) {
+				return resource.recreate(oldResource, err)
+			}
+
 			return fmt.Errorf("unable to update resource; %v", err)
 		}
+
+		if err := resource.recordDrift(oldResource); err != nil {
+			return err
+		}
+
+		resource.countOperation(OperationUpdate)
+	} else {
+		resource.countOperation(OperationNoop)
+	}
+
+	return nil
+}
+
+// recordDrift records the drift of an updated resource on its condition, as an event and as a
+// metric.  The differences of a resource from its desired state are only drift, i.e. changes made
+// to the resource outside of the controller, once the component has been reconciled at its current
+// generation, so that changes to the spec of the component are not reported as drift.
+func (resource *Resource) recordDrift(oldResource *Resource) error {
+	component := resource.Reconciler.GetComponent()
+
+	ready := meta.FindStatusCondition(component.GetConditions(), common.ConditionTypeReady)
+	if ready == nil ||
+		ready.Reason != common.ReasonReconciled ||
+		ready.ObservedGeneration != component.(client.Object).GetGeneration() {
+		resource.recordEvent("Updated", "updated resource")
+
+		return nil
+	}
+
+	paths, err := ChangedPaths(*resource, *oldResource)
+	if err != nil {
+		return err
+	}
+
+	commonResource := resource.ToCommonResource()
+	if found := commonResource.GetResourceIndex(component); found >= 0 {
+		commonResource.ResourceCondition = component.GetResources()[found].ResourceCondition
+	}
+
+	commonResource.LastDriftTime = time.Now().UTC().String()
+	commonResource.DriftCorrections++
+	commonResource.DriftedPaths = SummarizePaths(paths)
+	component.SetResource(*commonResource)
+
+	resource.recordEvent("DriftCorrected", "corrected changes made outside of the controller to paths ["+commonResource.DriftedPaths+"] of resource")
+	resource.countDriftCorrection()
+
+	return nil
+}
+
+// immutableFieldMessages are the messages of the causes of invalid errors which indicate that a
+// resource cannot be updated because immutable fields are changed.
+var immutableFieldMessages = []string{
+	"field is immutable",
+	"may not change once set",
+	"updates to statefulset spec for fields other than",
+}
+
+// IsImmutableFieldError determines if an error is the result of an update of a resource which
+// changes immutable fields, e.g. the template of a Job or the selector of a Deployment.
+func IsImmutableFieldError(err error) bool {
+	if !errors.IsInvalid(err) {
+		return false
+	}
+
+	messages := []string{err.Error()}
+
+	if status, ok := err.(errors.APIStatus); ok && status.Status().Details != nil {
+		for _, cause := range status.Status().Details.Causes {
+			messages = append(messages, cause.Message)
+		}
+	}
+
+	for _, message := range messages {
+		for _, immutableFieldMessage := range immutableFieldMessages {
+			if strings.Contains(message, immutableFieldMessage) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// recreate deletes a resource which cannot be updated because immutable fields are changed and
+// creates it again, according to its recreate policy.  The dependents of the resource are orphaned
+// rather than deleted with the orphan policy, e.g. so that the pods and volumes of a StatefulSet
+// are kept and adopted by the StatefulSet once it is created again.
+func (resource *Resource) recreate(oldResource *Resource, updateErr error) error {
+	policy := resource.GetRecreatePolicy()
+	if policy == RecreatePolicyNever {
+		return fmt.Errorf("%w; %v", ErrImmutableField, updateErr)
+	}
+
+	propagation := metav1.DeletePropagationBackground
+	if policy == RecreatePolicyOrphan {
+		propagation = metav1.DeletePropagationOrphan
+	}
+
+	resource.Reconciler.GetLogger().V(0).Info(fmt.Sprintf("recreating resource as immutable fields are changed; kind: [%s], name: [%s], namespace: [%s], policy: [%s]",
+		resource.Kind, resource.Name, resource.Namespace, policy))
+
+	// only delete the resource which failed to update rather than a resource which replaced it
+	uid := oldResource.Object.GetUID()
+
+	if err := resource.Reconciler.Delete(
+		resource.Reconciler.GetContext(),
+		oldResource.Object,
+		client.PropagationPolicy(propagation),
+		client.Preconditions{UID: &uid},
+	); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete resource to recreate it; %v", err)
+	}
+
+	resource.recordEvent("Recreating", fmt.Sprintf("deleted resource with %s propagation to create it again as immutable fields are changed", propagation))
+	resource.countOperation(OperationRecreate)
+
+	// record the recreation on the condition of the resource
+	component := resource.Reconciler.GetComponent()
+	commonResource := resource.ToCommonResource()
+
+	if found := commonResource.GetResourceIndex(component); found >= 0 {
+		commonResource.ResourceCondition = component.GetResources()[found].ResourceCondition
+	}
+
+	commonResource.LastRecreateTime = time.Now().UTC().String()
+	commonResource.RecreateReason = updateErr.Error()
+	component.SetResource(*commonResource)
+
+	// a resource with finalizers may still be deleting, in which case it is created by a later
+	// reconciliation once it is deleted
+	if err := resource.Create(); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return fmt.Errorf("%w; waiting for the deletion of the resource to create it again", ErrImmutableField)
+		}
+
+		return err
 	}
 
 	return nil
 }
 
+// recordEvent records a normal event for a resource on the parent custom resource.
+func (resource *Resource) recordEvent(reason, message string) {
+	resource.Reconciler.GetEventRecorder().Eventf(
+		resource.Reconciler.GetComponent().(client.Object),
+		corev1.EventTypeNormal,
+		reason,
+		"%s; kind: [%s], name: [%s], namespace: [%s]",
+		message, resource.Kind, resource.Name, resource.Namespace,
+	)
+}
+
 // NewResourceFromClient returns a new resource given a client object.  It optionally will take in
 // a reconciler and set it.
 func NewResourceFromClient(resource client.Object, reconciler ...common.ComponentReconciler) *Resource {
@@ -292,10 +649,20 @@ func (resource *Resource) ToCommonResource() *common.Resource {
 	return commonResource
 }
 
-// IsReady returns whether a specific known resource is ready.  Always returns true for unknown resources
-// so that dependency checks will not fail and reconciliation of resources can happen with errors rather
-// than stopping entirely.
+// IsReady returns whether a specific known resource is ready.  The readiness of unknown resources is
+// determined by their standard status fields, if any, so that dependency checks will not fail for
+// resources without a status and reconciliation of resources can happen with errors rather than
+// stopping entirely.
 func (resource *Resource) IsReady() (bool, error) {
+	if resource.ReadyFunc != nil {
+		object, err := getUnstructuredObject(resource)
+		if err != nil || object == nil {
+			return false, err
+		}
+
+		return resource.ReadyFunc(object)
+	}
+
 	switch resource.Kind {
 	case NamespaceKind:
 		return NamespaceIsReady(resource)
@@ -315,9 +682,21 @@ func (resource *Resource) IsReady() (bool, error) {
 		return JobIsReady(resource)
 	case ServiceKind:
 		return ServiceIsReady(resource)
+	case PersistentVolumeClaimKind:
+		return PersistentVolumeClaimIsReady(resource)
+	case IngressKind:
+		return IngressIsReady(resource)
+	case CronJobKind:
+		return CronJobIsReady(resource)
+	case ReplicaSetKind:
+		return ReplicaSetIsReady(resource)
+	case PodKind:
+		return PodIsReady(resource)
+	case APIServiceKind:
+		return APIServiceIsReady(resource)
 	}
 
-	return true, nil
+	return GenericIsReady(resource)
 }
 
 // AreReady returns whether resources are ready.  All resources must be ready in order
@@ -335,19 +714,89 @@ func AreReady(resources ...common.ComponentResource) (bool, error) {
 
 // AreEqual determines if two resources are equal.
 func AreEqual(desired, actual Resource) (bool, error) {
-	mergedResource, err := actual.ToUnstructured()
+	diffResults, err := calculatePatch(desired, actual)
 	if err != nil {
 		return false, err
 	}
 
+	return diffResults.IsEmpty(), nil
+}
+
+// ChangedPaths returns the sorted paths of the fields of the actual resource which differ from the
+// desired resource, as determined by the patch which would update the actual resource.
+func ChangedPaths(desired, actual Resource) ([]string, error) {
+	diffResults, err := calculatePatch(desired, actual)
+	if err != nil {
+		return nil, err
+	}
+
+	if diffResults.IsEmpty() {
+		return []string{}, nil
+	}
+
+	diff := map[string]interface{}{}
+	if err := json.Unmarshal(diffResults.Patch, &diff); err != nil {
+		return nil, fmt.Errorf("unable to decode patch of resource; %v", err)
+	}
+
+	paths := patchPaths("", diff)
+	sort.Strings(paths)
+
+	return paths, nil
+}
+
+// SummarizePaths returns a compact summary of the paths of a resource which differ from its
+// desired state.
+func SummarizePaths(paths []string) string {
+	if len(paths) <= maxDriftedPaths {
+		return strings.Join(paths, ", ")
+	}
+
+	return fmt.Sprintf("%s and %d more", strings.Join(paths[:maxDriftedPaths], ", "), len(paths)-maxDriftedPaths)
+}
+
+// patchPaths returns the paths of the fields which are changed by a patch.  Lists are reported as
+// a single path and the directives of strategic merge patches, e.g. $setElementOrder, are ignored.
+func patchPaths(prefix string, diff map[string]interface{}) []string {
+	paths := []string{}
+
+	for key, value := range diff {
+		if strings.HasPrefix(key, "$") {
+			continue
+		}
+
+		path := key
+		if prefix != "" {
+			path = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]interface{}); ok && len(nested) > 0 {
+			paths = append(paths, patchPaths(path, nested)...)
+
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// calculatePatch calculates the patch which would update the actual resource to its desired state.
+func calculatePatch(desired, actual Resource) (*patch.PatchResult, error) {
+	mergedResource, err := actual.ToUnstructured()
+	if err != nil {
+		return nil, err
+	}
+
 	actualResource, err := actual.ToUnstructured()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	desiredResource, err := desired.ToUnstructured()
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
 	// ensure that resource versions and observed generation do not interfere
@@ -377,16 +826,11 @@ func AreEqual(desired, actual Resource) (bool, error) {
 		patch.IgnorePDBSelector(),
 	}
 
-	diffResults, err := patch.DefaultPatchMaker.Calculate(
+	return patch.DefaultPatchMaker.Calculate(
 		actualResource,
 		mergedResource,
 		diffOptions...,
 	)
-	if err != nil {
-		return false, err
-	}
-
-	return diffResults.IsEmpty(), nil
 }
 
 // NeedsUpdate determines if a resource needs to be updated.
@@ -467,6 +911,11 @@ func (resource *Resource) GetNamespace() string {
 	return resource.Namespace
 }
 
+// GetWave returns the Wave field of a Resource.
+func (resource *Resource) GetWave() int {
+	return resource.Wave
+}
+
 // getObject returns an object based on an input object, and a destination object.
 // TODO: move to controller utils as this is not specific to resources.
 func getObject(source common.ComponentResource, destination client.Object, allowMissing bool) error {
@@ -488,43 +937,599 @@ func getObject(source common.ComponentResource, destination client.Object, allow
 }
 `
 
-const namespaceTemplate = `{{ .Boilerplate }}
+const metricsTemplate = `{{ .Boilerplate }}
 
 package resources
 
 import (
-	v1 "k8s.io/api/core/v1"
-
-	"{{ .Repo }}/apis/common"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 )
 
 const (
-	NamespaceKind = "Namespace"
+	OperationCreate = "create"
+	OperationUpdate = "update"
+	OperationNoop     = "noop"
+	OperationRecreate = "recreate"
 )
 
-// NamespaceIsReady defines the criteria for a namespace to be condsidered ready.
-func NamespaceIsReady(resource common.ComponentResource) (bool, error) {
-	var namespace v1.Namespace
-	if err := getObject(resource, &namespace, true); err != nil {
-		return false, err
-	}
+// resourceOperations counts the operations which are performed to persist child resources.
+var resourceOperations = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "reconciler_child_resource_operations_total",
+		Help: "Number of operations performed to persist child resources by group, version, kind and operation.",
+	},
+	[]string{"group", "version", "kind", "operation"},
+)
 
-	// if we have a name that is empty, we know we did not find the object
-	if namespace.Name == "" {
-		return false, nil
-	}
+// resourceDriftCorrections counts the corrections of changes which are made to child resources
+// outside of the controller.  The child resources are not labeled by name, which would make the
+// number of series unbounded; the corrections of each child resource are recorded in its status.
+var resourceDriftCorrections = prometheus.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "reconciler_child_resource_drift_corrections_total",
+		Help: "Number of corrections of changes made to child resources outside of the controller by group, version and kind.",
+	},
+	[]string{"group", "version", "kind"},
+)
 
-	// if the namespace is terminating, it is not considered ready
-	if namespace.Status.Phase == v1.NamespaceTerminating {
-		return false, nil
-	}
+func init() {
+	metrics.Registry.MustRegister(resourceOperations, resourceDriftCorrections)
+}
 
-	// finally, rely on the active field to determine if this namespace is ready
-	return namespace.Status.Phase == v1.NamespaceActive, nil
+// countOperation counts an operation which is performed to persist a resource.
+func (resource *Resource) countOperation(operation string) {
+	resourceOperations.WithLabelValues(resource.Group, resource.Version, resource.Kind, operation).Inc()
 }
 
-// NamespaceForResourceIsReady checks to see if the namespace of a resource is ready.
-func NamespaceForResourceIsReady(resource common.ComponentResource) (bool, error) {
+// countDriftCorrection counts a correction of changes which are made to a resource outside of the
+// controller.
+func (resource *Resource) countDriftCorrection() {
+	resourceDriftCorrections.WithLabelValues(resource.Group, resource.Version, resource.Kind).Inc()
+}
+`
+
+const persistenceTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"errors"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PersistenceStrategy defines how a resource is persisted to the cluster.
+type PersistenceStrategy string
+
+const (
+	// PersistenceStrategyPatch persists a resource with a merge patch when it differs
+	// from the desired state.
+	PersistenceStrategyPatch PersistenceStrategy = "patch"
+
+	// PersistenceStrategyApply persists a resource with server-side apply.
+	PersistenceStrategyApply PersistenceStrategy = "apply"
+)
+
+const (
+	// DefaultPersistenceStrategy is the persistence strategy for resources of kinds
+	// without a specific persistence strategy.
+	DefaultPersistenceStrategy = PersistenceStrategy({{ printf "%q" (.Persistence.GetStrategy "") }})
+
+	// ForceApplyConflicts determines whether conflicts with other field managers are
+	// forced or reported when a resource is persisted with server-side apply.
+	ForceApplyConflicts = {{ .Persistence.ForceConflicts }}
+)
+
+var (
+	ErrApplyConflict  = errors.New("resource has conflicts with other field managers")
+	ErrImmutableField = errors.New("resource cannot be updated as immutable fields are changed")
+)
+
+// RecreatePolicy defines whether a resource is deleted and created again when it cannot be updated
+// because immutable fields are changed.
+type RecreatePolicy string
+
+const (
+	// RecreatePolicyNever fails the update of a resource which changes immutable fields.
+	RecreatePolicyNever RecreatePolicy = "never"
+
+	// RecreatePolicyRecreate deletes a resource along with its dependents and creates it again.
+	RecreatePolicyRecreate RecreatePolicy = "recreate"
+
+	// RecreatePolicyOrphan deletes a resource while orphaning its dependents and creates it again.
+	RecreatePolicyOrphan RecreatePolicy = "orphan"
+)
+
+// DefaultRecreatePolicy is the recreate policy for resources of kinds without a specific
+// recreate policy.
+const DefaultRecreatePolicy = RecreatePolicy({{ printf "%q" (.Persistence.GetRecreatePolicy "") }})
+
+// recreatePolicies defines the recreate policy for resources of specific kinds.
+var recreatePolicies = map[string]RecreatePolicy{
+	{{- range $kind, $policy := .Persistence.Recreate.Kinds }}
+	{{ printf "%q" $kind }}: RecreatePolicy({{ printf "%q" $policy }}),
+	{{- end }}
+}
+
+// GetRecreatePolicy returns the recreate policy of a resource, either from the resource marker of
+// the desired resource or from its kind.
+func (resource *Resource) GetRecreatePolicy() RecreatePolicy {
+	if resource.RecreatePolicy != "" {
+		return resource.RecreatePolicy
+	}
+
+	// the resource which is persisted is created from the desired object, without its marker
+	if resource.Reconciler != nil {
+		for _, desired := range resource.Reconciler.GetResources() {
+			if desired.EqualGVK(resource) && desired.EqualNamespaceName(resource) {
+				if policy := desired.(*Resource).RecreatePolicy; policy != "" {
+					return policy
+				}
+			}
+		}
+	}
+
+	if policy, ok := recreatePolicies[resource.Kind]; ok {
+		return policy
+	}
+
+	return DefaultRecreatePolicy
+}
+
+// persistenceStrategies defines the persistence strategy for resources of specific kinds.
+var persistenceStrategies = map[string]PersistenceStrategy{
+	{{- range $kind, $strategy := .Persistence.Kinds }}
+	{{ printf "%q" $kind }}: PersistenceStrategy({{ printf "%q" $strategy }}),
+	{{- end }}
+}
+
+// GetPersistenceStrategy returns the persistence strategy of a resource.
+func (resource *Resource) GetPersistenceStrategy() PersistenceStrategy {
+	if strategy, ok := persistenceStrategies[resource.Kind]; ok {
+		return strategy
+	}
+
+	return DefaultPersistenceStrategy
+}
+
+// IsApplyConflict determines if an error is the result of a conflict with another
+// field manager when a resource is persisted with server-side apply.
+func IsApplyConflict(err error) bool {
+	return errors.Is(err, ErrApplyConflict)
+}
+
+// apply persists a resource with server-side apply.  The old resource is the current resource in
+// the cluster, or nil if the resource does not yet exist.
+func (resource *Resource) apply(oldResource *Resource) error {
+	resource.Reconciler.GetLogger().V(4).Info(fmt.Sprintf("applying resource; kind: [%s], name: [%s], namespace: [%s]",
+		resource.Kind, resource.Name, resource.Namespace))
+
+	// an applied object must not contain a resource version or managed fields, and
+	// must not affect the desired state of the resource object in memory.  the object is
+	// copied by conversion as the objects which are created in memory may hold values
+	// which cannot be deep copied, e.g. int.
+	object, err := resource.ToUnstructured()
+	if err != nil {
+		return fmt.Errorf("unable to apply resource; %v", err)
+	}
+
+	object.SetResourceVersion("")
+	object.SetManagedFields(nil)
+
+	options := []client.PatchOption{client.FieldOwner(FieldManager)}
+	if ForceApplyConflicts {
+		options = append(options, client.ForceOwnership)
+	}
+
+	if err := resource.Reconciler.Patch(
+		resource.Reconciler.GetContext(),
+		object,
+		client.Apply,
+		options...,
+	); err != nil {
+		if apierrs.IsConflict(err) {
+			return fmt.Errorf("%w; %v", ErrApplyConflict, err)
+		}
+
+		if oldResource != nil && IsImmutableFieldError(err) {
+			return resource.recreate(oldResource, err)
+		}
+
+		return fmt.Errorf("unable to apply resource; %v", err)
+	}
+
+	// an unchanged resource version means that applying the resource was a no-op, while a
+	// changed resource version means that the resource differed from its desired state
+	switch {
+	case oldResource == nil:
+		resource.recordEvent("Created", "created resource")
+		resource.countOperation(OperationCreate)
+	case object.GetResourceVersion() != oldResource.Object.GetResourceVersion():
+		if err := resource.recordDrift(oldResource); err != nil {
+			return err
+		}
+
+		resource.countOperation(OperationUpdate)
+	default:
+		resource.countOperation(OperationNoop)
+	}
+
+	return nil
+}
+`
+
+const configHashTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	// ConfigHashAnnotation is the annotation of the pod template of a workload which holds the hash
+	// of the contents of the ConfigMaps and Secrets that it references, so that the pods of the
+	// workload are restarted when the contents change.
+	ConfigHashAnnotation = "{{ .Domain }}/config-hash"
+
+	// RestartOnConfigChange determines whether the hash of the ConfigMaps and Secrets which are
+	// referenced by a workload is stamped on the pod template of the workload.
+	RestartOnConfigChange = {{ .Persistence.RestartOnConfigChange }}
+)
+
+// configReference is a reference of a pod template to a ConfigMap or Secret.
+type configReference struct {
+	kind string
+	name string
+}
+
+// SetConfigHashes stamps the hash of the contents of the ConfigMaps and Secrets among the resources
+// of a component on the pod templates of the Deployments, StatefulSets and DaemonSets among the
+// resources which reference them.  Only the ConfigMaps and Secrets which are resources of the same
+// component are hashed, as other ConfigMaps and Secrets are not changed by the controller.
+func SetConfigHashes(resources []common.ComponentResource) error {
+	if !RestartOnConfigChange {
+		return nil
+	}
+
+	// collect the contents of the ConfigMaps and Secrets by their kind, namespace and name
+	configs := map[string]interface{}{}
+
+	for _, resource := range resources {
+		if resource.GetKind() != ConfigMapKind && resource.GetKind() != SecretKind {
+			continue
+		}
+
+		object, err := toUnstructuredObject(resource.GetObject())
+		if err != nil {
+			return err
+		}
+
+		configs[configKey(resource.GetKind(), resource.GetNamespace(), resource.GetName())] = map[string]interface{}{
+			"data":       object["data"],
+			"binaryData": object["binaryData"],
+			"stringData": object["stringData"],
+		}
+	}
+
+	if len(configs) == 0 {
+		return nil
+	}
+
+	for _, resource := range resources {
+		switch resource.GetKind() {
+		case DeploymentKind, StatefulSetKind, DaemonSetKind:
+		default:
+			continue
+		}
+
+		if err := setConfigHash(resource, configs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// setConfigHash stamps the hash of the contents of the ConfigMaps and Secrets which are referenced by
+// the pod template of a workload on the pod template.
+func setConfigHash(resource common.ComponentResource, configs map[string]interface{}) error {
+	object, err := toUnstructuredObject(resource.GetObject())
+	if err != nil {
+		return err
+	}
+
+	podSpec := nestedObjectMap(object, "spec", "template", "spec")
+	if podSpec == nil {
+		return nil
+	}
+
+	referenced := map[string]interface{}{}
+
+	for _, reference := range podSpecConfigReferences(podSpec) {
+		key := configKey(reference.kind, resource.GetNamespace(), reference.name)
+
+		if content, ok := configs[key]; ok {
+			referenced[key] = content
+		}
+	}
+
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	// maps are encoded in the order of their keys, so that the hash is stable
+	content, err := json.Marshal(referenced)
+	if err != nil {
+		return err
+	}
+
+	hash := sha256.Sum256(content)
+
+	if err := unstructured.SetNestedField(
+		object,
+		hex.EncodeToString(hash[:]),
+		"spec", "template", "metadata", "annotations", ConfigHashAnnotation,
+	); err != nil {
+		return err
+	}
+
+	return fromUnstructuredObject(object, resource.GetObject())
+}
+
+// podSpecConfigReferences returns the references of a pod spec to ConfigMaps and Secrets from its
+// volumes and from the environment of its containers.
+func podSpecConfigReferences(podSpec map[string]interface{}) []configReference {
+	references := []configReference{}
+
+	volumes := nestedItems(podSpec, "volumes")
+	for _, volume := range objectMaps(volumes) {
+		references = appendConfigReference(references, ConfigMapKind, volume, "configMap", "name")
+		references = appendConfigReference(references, SecretKind, volume, "secret", "secretName")
+
+		sources := nestedItems(volume, "projected", "sources")
+		for _, source := range objectMaps(sources) {
+			references = appendConfigReference(references, ConfigMapKind, source, "configMap", "name")
+			references = appendConfigReference(references, SecretKind, source, "secret", "name")
+		}
+	}
+
+	for _, field := range []string{"initContainers", "containers"} {
+		containers := nestedItems(podSpec, field)
+		for _, container := range objectMaps(containers) {
+			envFrom := nestedItems(container, "envFrom")
+			for _, source := range objectMaps(envFrom) {
+				references = appendConfigReference(references, ConfigMapKind, source, "configMapRef", "name")
+				references = appendConfigReference(references, SecretKind, source, "secretRef", "name")
+			}
+
+			env := nestedItems(container, "env")
+			for _, variable := range objectMaps(env) {
+				references = appendConfigReference(references, ConfigMapKind, variable, "valueFrom", "configMapKeyRef", "name")
+				references = appendConfigReference(references, SecretKind, variable, "valueFrom", "secretKeyRef", "name")
+			}
+		}
+	}
+
+	return references
+}
+
+// appendConfigReference appends the reference to a ConfigMap or Secret of a kind whose name is found
+// at the path of the fields in an object.
+func appendConfigReference(
+	references []configReference,
+	kind string,
+	object map[string]interface{},
+	fields ...string,
+) []configReference {
+	if name, found, _ := unstructured.NestedString(object, fields...); found && name != "" {
+		return append(references, configReference{kind: kind, name: name})
+	}
+
+	return references
+}
+
+// nestedObjectMap returns the object at the path of the fields in an object, or nil if there is no
+// object at the path.  The object is not copied, as the content of the child resources which are
+// created in memory may hold values which cannot be copied as JSON, e.g. values of type int.
+func nestedObjectMap(object map[string]interface{}, fields ...string) map[string]interface{} {
+	value, _, _ := unstructured.NestedFieldNoCopy(object, fields...)
+	nested, _ := value.(map[string]interface{})
+
+	return nested
+}
+
+// nestedItems returns the items of the list at the path of the fields in an object, or nil if there
+// is no list at the path.  The list is not copied for the same reason as by nestedObjectMap.
+func nestedItems(object map[string]interface{}, fields ...string) []interface{} {
+	value, _, _ := unstructured.NestedFieldNoCopy(object, fields...)
+	items, _ := value.([]interface{})
+
+	return items
+}
+
+// objectMaps returns the items of a list which are objects.
+func objectMaps(items []interface{}) []map[string]interface{} {
+	objects := []map[string]interface{}{}
+
+	for _, item := range items {
+		if object, ok := item.(map[string]interface{}); ok {
+			objects = append(objects, object)
+		}
+	}
+
+	return objects
+}
+
+// configKey returns the key of a ConfigMap or Secret by its kind, namespace and name.
+func configKey(kind, namespace, name string) string {
+	return kind + "/" + namespace + "/" + name
+}
+
+// toUnstructuredObject returns the content of an object.  The content of an unstructured object is
+// returned as is, so that changes to the content change the object.
+func toUnstructuredObject(object client.Object) (map[string]interface{}, error) {
+	if unstructuredObject, ok := object.(*unstructured.Unstructured); ok {
+		return unstructuredObject.Object, nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.ToUnstructured(object)
+}
+
+// fromUnstructuredObject sets the content of an object which is not unstructured.
+func fromUnstructuredObject(content map[string]interface{}, object client.Object) error {
+	if _, ok := object.(*unstructured.Unstructured); ok {
+		return nil
+	}
+
+	return runtime.DefaultUnstructuredConverter.FromUnstructured(content, object)
+}
+`
+
+const ownershipTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ParentKindLabel, ParentNameLabel and ParentNamespaceLabel are the labels which identify
+	// the parent of a cluster-scoped child resource of a namespaced component, as a cluster-scoped
+	// resource may not have an owner reference to a namespaced resource.
+	ParentKindLabel      = "{{ .Domain }}/parent-kind"
+	ParentNameLabel      = "{{ .Domain }}/parent-name"
+	ParentNamespaceLabel = "{{ .Domain }}/parent-namespace"
+)
+
+// SetParentLabels sets the labels which identify the parent of a child resource.  The parent
+// labels are used in place of an owner reference for a cluster-scoped child resource of a
+// namespaced parent.
+func SetParentLabels(child metav1.Object, parentKind string, parent client.Object) error {
+	parentLabels := map[string]string{
+		ParentKindLabel:      parentKind,
+		ParentNameLabel:      parent.GetName(),
+		ParentNamespaceLabel: parent.GetNamespace(),
+	}
+
+	labels := child.GetLabels()
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	for key, value := range parentLabels {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return fmt.Errorf("unable to set label %s on resource %s; %s", key, child.GetName(), strings.Join(errs, "; "))
+		}
+
+		labels[key] = value
+	}
+
+	child.SetLabels(labels)
+
+	return nil
+}
+
+// IsOwnedBy determines if a child resource is owned by a parent, either by its controller
+// reference or by its parent labels.
+func IsOwnedBy(child metav1.Object, parentKind string, parent client.Object) bool {
+	if metav1.IsControlledBy(child, parent) {
+		return true
+	}
+
+	labels := child.GetLabels()
+
+	return labels[ParentKindLabel] == parentKind &&
+		labels[ParentNameLabel] == parent.GetName() &&
+		labels[ParentNamespaceLabel] == parent.GetNamespace()
+}
+
+// ParentOf returns the name and namespace of the parent of a kind which owns a child resource,
+// either by its controller reference or by its parent labels.  A cluster-scoped parent has no
+// namespace, otherwise the parent of a child resource with a controller reference is in the
+// namespace of the child resource.
+func ParentOf(child metav1.Object, parentGVK schema.GroupVersionKind, parentClusterScoped bool) (types.NamespacedName, bool) {
+	if owner := metav1.GetControllerOf(child); owner != nil {
+		ownerGV, err := schema.ParseGroupVersion(owner.APIVersion)
+		if err == nil && ownerGV.Group == parentGVK.Group && owner.Kind == parentGVK.Kind {
+			parent := types.NamespacedName{Name: owner.Name}
+			if !parentClusterScoped {
+				parent.Namespace = child.GetNamespace()
+			}
+
+			return parent, true
+		}
+	}
+
+	labels := child.GetLabels()
+	if labels[ParentKindLabel] != parentGVK.Kind || labels[ParentNameLabel] == "" {
+		return types.NamespacedName{}, false
+	}
+
+	return types.NamespacedName{
+		Name:      labels[ParentNameLabel],
+		Namespace: labels[ParentNamespaceLabel],
+	}, true
+}
+`
+
+const namespaceTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	NamespaceKind = "Namespace"
+)
+
+// NamespaceIsReady defines the criteria for a namespace to be condsidered ready.
+func NamespaceIsReady(resource common.ComponentResource) (bool, error) {
+	var namespace v1.Namespace
+	if err := getObject(resource, &namespace, true); err != nil {
+		return false, err
+	}
+
+	// if we have a name that is empty, we know we did not find the object
+	if namespace.Name == "" {
+		return false, nil
+	}
+
+	// if the namespace is terminating, it is not considered ready
+	if namespace.Status.Phase == v1.NamespaceTerminating {
+		return false, nil
+	}
+
+	// finally, rely on the active field to determine if this namespace is ready
+	return namespace.Status.Phase == v1.NamespaceActive, nil
+}
+
+// NamespaceForResourceIsReady checks to see if the namespace of a resource is ready.
+func NamespaceForResourceIsReady(resource common.ComponentResource) (bool, error) {
 	// create a stub namespace resource to pass to the NamespaceIsReady method
 	namespace := &Resource{
 		Reconciler: resource.GetReconciler(),
@@ -876,3 +1881,376 @@ func StatefulSetIsReady(resource common.ComponentResource, expectedKeys ...strin
 	return true, nil
 }
 `
+
+const persistentVolumeClaimTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	PersistentVolumeClaimKind = "PersistentVolumeClaim"
+)
+
+// PersistentVolumeClaimIsReady checks to see if a persistent volume claim is ready.
+func PersistentVolumeClaimIsReady(resource common.ComponentResource) (bool, error) {
+	var claim v1.PersistentVolumeClaim
+	if err := getObject(resource, &claim, true); err != nil {
+		return false, err
+	}
+
+	// if we have a name that is empty, we know we did not find the object
+	if claim.Name == "" {
+		return false, nil
+	}
+
+	// a claim is ready once it is bound to a volume
+	return claim.Status.Phase == v1.ClaimBound, nil
+}
+`
+
+const ingressTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	IngressKind = "Ingress"
+)
+
+// IngressIsReady checks to see if an ingress is ready.  The ingress is retrieved as an unstructured
+// object as it is served by several API versions.
+func IngressIsReady(resource common.ComponentResource) (bool, error) {
+	ingress, err := getUnstructuredObject(resource)
+	if err != nil || ingress == nil {
+		return false, err
+	}
+
+	// an ingress is ready once it exists, as many ingress controllers never publish a load
+	// balancer status, e.g. those which are exposed by a node port or host network
+	return true, nil
+}
+`
+
+const cronJobTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	CronJobKind = "CronJob"
+)
+
+// CronJobIsReady checks to see if a cron job is ready.  The cron job is retrieved as an unstructured
+// object as it is served by several API versions.
+func CronJobIsReady(resource common.ComponentResource) (bool, error) {
+	cronJob, err := getUnstructuredObject(resource)
+	if err != nil || cronJob == nil {
+		return false, err
+	}
+
+	// a cron job is ready once it exists, as its jobs are only created on schedule
+	return true, nil
+}
+`
+
+const replicaSetTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	appsv1 "k8s.io/api/apps/v1"
+
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	ReplicaSetKind = "ReplicaSet"
+)
+
+// ReplicaSetIsReady checks to see if a replica set is ready.
+func ReplicaSetIsReady(resource common.ComponentResource) (bool, error) {
+	var replicaSet appsv1.ReplicaSet
+	if err := getObject(resource, &replicaSet, true); err != nil {
+		return false, err
+	}
+
+	// if we have a name that is empty, we know we did not find the object
+	if replicaSet.Name == "" {
+		return false, nil
+	}
+
+	// rely on observed generation to give us a proper status
+	if replicaSet.Generation != replicaSet.Status.ObservedGeneration {
+		return false, nil
+	}
+
+	// the desired replicas default to 1 when unset
+	replicas := int32(1)
+	if replicaSet.Spec.Replicas != nil {
+		replicas = *replicaSet.Spec.Replicas
+	}
+
+	return replicaSet.Status.ReadyReplicas >= replicas, nil
+}
+`
+
+const podTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	v1 "k8s.io/api/core/v1"
+
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	PodKind = "Pod"
+)
+
+// PodIsReady checks to see if a pod is ready.
+func PodIsReady(resource common.ComponentResource) (bool, error) {
+	var pod v1.Pod
+	if err := getObject(resource, &pod, true); err != nil {
+		return false, err
+	}
+
+	// if we have a name that is empty, we know we did not find the object
+	if pod.Name == "" {
+		return false, nil
+	}
+
+	// a pod which has run to completion is considered ready
+	if pod.Status.Phase == v1.PodSucceeded {
+		return true, nil
+	}
+
+	if pod.Status.Phase != v1.PodRunning {
+		return false, nil
+	}
+
+	// a running pod must also report the ready condition
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == v1.PodReady {
+			return condition.Status == v1.ConditionTrue, nil
+		}
+	}
+
+	return false, nil
+}
+`
+
+const apiServiceTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	"{{ .Repo }}/apis/common"
+)
+
+const (
+	APIServiceKind = "APIService"
+)
+
+// APIServiceIsReady checks to see if an api service is ready.  The api service is retrieved as an
+// unstructured object to avoid a dependency on the aggregator API types.
+func APIServiceIsReady(resource common.ComponentResource) (bool, error) {
+	apiService, err := getUnstructuredObject(resource)
+	if err != nil || apiService == nil {
+		return false, err
+	}
+
+	// an api service is ready once it reports that it is available
+	status, found, err := getConditionStatus(apiService, "Available")
+	if err != nil || !found {
+		return false, err
+	}
+
+	return status == "True", nil
+}
+`
+
+const genericTemplate = `{{ .Boilerplate }}
+
+package resources
+
+import (
+	goerrors "errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"{{ .Repo }}/apis/common"
+)
+
+var ErrInvalidFieldCheck = goerrors.New("invalid field check")
+
+// FieldCheck is a comparison of a field of a resource to a value, as generated from the ready
+// expression of a resource marker.  The value of a numeric comparison is a float64.
+type FieldCheck struct {
+	Path     []string
+	Operator string
+	Value    interface{}
+}
+
+// GenericIsReady checks to see if a resource of an unknown kind is ready by using its standard
+// status fields.  A resource is not ready when it does not exist, when its status has not observed
+// the current generation or when its Ready (or else Available) condition is not true.  A resource
+// without any of these status fields is ready once it exists.
+func GenericIsReady(resource common.ComponentResource) (bool, error) {
+	object, err := getUnstructuredObject(resource)
+	if err != nil || object == nil {
+		return false, err
+	}
+
+	// rely on observed generation to give us a proper status
+	observedGeneration, found, err := unstructured.NestedInt64(object.Object, "status", "observedGeneration")
+	if err != nil {
+		return false, err
+	}
+
+	if found && observedGeneration < object.GetGeneration() {
+		return false, nil
+	}
+
+	// rely on the standard conditions, in order of preference
+	for _, conditionType := range []string{"Ready", "Available"} {
+		status, found, err := getConditionStatus(object, conditionType)
+		if err != nil {
+			return false, err
+		}
+
+		if found {
+			return status == "True", nil
+		}
+	}
+
+	return true, nil
+}
+
+// getUnstructuredObject returns the current state of a resource as an unstructured object, or
+// nil if the resource does not exist.
+func getUnstructuredObject(resource common.ComponentResource) (*unstructured.Unstructured, error) {
+	object := &unstructured.Unstructured{}
+	object.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   resource.GetGroup(),
+		Version: resource.GetVersion(),
+		Kind:    resource.GetKind(),
+	})
+
+	namespacedName := types.NamespacedName{
+		Name:      resource.GetName(),
+		Namespace: resource.GetNamespace(),
+	}
+
+	if err := resource.GetReconciler().Get(resource.GetReconciler().GetContext(), namespacedName, object); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+
+		return nil, err
+	}
+
+	return object, nil
+}
+
+// getConditionStatus returns the status of a condition in the status.conditions field of an
+// unstructured object.
+func getConditionStatus(object *unstructured.Unstructured, conditionType string) (string, bool, error) {
+	conditions, found, err := unstructured.NestedSlice(object.Object, "status", "conditions")
+	if err != nil || !found {
+		return "", false, err
+	}
+
+	for _, condition := range conditions {
+		fields, ok := condition.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		if fields["type"] == conditionType {
+			status, _, err := unstructured.NestedString(fields, "status")
+
+			return status, true, err
+		}
+	}
+
+	return "", false, nil
+}
+
+// FieldChecksAreTrue determines if all field checks are true for an unstructured object.  A check of
+// a field which is not set is false.
+func FieldChecksAreTrue(object *unstructured.Unstructured, checks ...FieldCheck) (bool, error) {
+	for _, check := range checks {
+		value, found, err := unstructured.NestedFieldNoCopy(object.Object, check.Path...)
+		if err != nil || !found {
+			return false, err
+		}
+
+		isTrue, err := check.isTrue(value)
+		if err != nil || !isTrue {
+			return false, err
+		}
+	}
+
+	return true, nil
+}
+
+// isTrue determines if a field check is true for the value of a field.
+func (check FieldCheck) isTrue(value interface{}) (bool, error) {
+	expected, isNumber := check.Value.(float64)
+	if !isNumber {
+		switch check.Operator {
+		case "==":
+			return value == check.Value, nil
+		case "!=":
+			return value != check.Value, nil
+		}
+
+		return false, fmt.Errorf("%w; operator %s requires a numeric value", ErrInvalidFieldCheck, check.Operator)
+	}
+
+	var actual float64
+
+	switch v := value.(type) {
+	case int64:
+		actual = float64(v)
+	case float64:
+		actual = v
+	default:
+		return false, fmt.Errorf("%w; field %s is not a number", ErrInvalidFieldCheck, strings.Join(check.Path, "."))
+	}
+
+	switch check.Operator {
+	case "==":
+		return actual == expected, nil
+	case "!=":
+		return actual != expected, nil
+	case ">=":
+		return actual >= expected, nil
+	case "<=":
+		return actual <= expected, nil
+	case ">":
+		return actual > expected, nil
+	case "<":
+		return actual < expected, nil
+	}
+
+	return false, fmt.Errorf("%w; unknown operator %s", ErrInvalidFieldCheck, check.Operator)
+}
+`
@@ -0,0 +1,100 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package scope
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Scope{}
+
+// Scope scaffolds the package of the operator which holds the namespaces it watches.
+type Scope struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+}
+
+func (f *Scope) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "scope", "scope.go")
+
+	f.TemplateBody = scopeTemplate
+
+	return nil
+}
+
+const scopeTemplate = `{{ .Boilerplate }}
+
+package scope
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+)
+
+// namespaceFile is the file which holds the namespace of the service account of the operator
+// when it runs in a pod.
+const namespaceFile = "/var/run/secrets/kubernetes.io/serviceaccount/namespace"
+
+var ErrMissingNamespace = errors.New("unable to determine the namespace to watch; set the --watch-namespace flag")
+
+// namespaces are the namespaces which the operator watches.
+var namespaces []string
+
+// SetNamespaces sets the namespaces which the operator watches from the comma-separated value of
+// the --watch-namespace flag.  The namespace which the operator runs in is watched when the value
+// is empty.
+func SetNamespaces(watchNamespace string) error {
+	namespaces = nil
+
+	for _, namespace := range strings.Split(watchNamespace, ",") {
+		if namespace = strings.TrimSpace(namespace); namespace != "" {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+
+	if len(namespaces) > 0 {
+		return nil
+	}
+
+	content, err := ioutil.ReadFile(namespaceFile)
+	if err != nil {
+		return fmt.Errorf("%w; %v", ErrMissingNamespace, err)
+	}
+
+	namespace := strings.TrimSpace(string(content))
+	if namespace == "" {
+		return ErrMissingNamespace
+	}
+
+	namespaces = []string{namespace}
+
+	return nil
+}
+
+// Namespaces returns the namespaces which the operator watches.  No namespaces are returned when
+// the operator watches all namespaces.
+func Namespaces() []string {
+	return namespaces
+}
+
+// ApplyToOptions restricts the cache of the manager to the namespaces which the operator watches.
+func ApplyToOptions(options *ctrl.Options) {
+	switch len(namespaces) {
+	case 0:
+		return
+	case 1:
+		options.Namespace = namespaces[0]
+	default:
+		options.NewCache = cache.MultiNamespacedCacheBuilder(namespaces)
+	}
+}
+`
@@ -0,0 +1,117 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package tracing
+
+import (
+	"path/filepath"
+
+	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+)
+
+var _ machinery.Template = &Tracing{}
+
+// Tracing scaffolds the tracing package of the operator.
+type Tracing struct {
+	machinery.TemplateMixin
+	machinery.BoilerplateMixin
+	machinery.RepositoryMixin
+
+	ServiceName string
+}
+
+func (f *Tracing) SetTemplateDefaults() error {
+	f.Path = filepath.Join("internal", "tracing", "tracing.go")
+
+	f.TemplateBody = tracingTemplate
+
+	return nil
+}
+
+const tracingTemplate = `{{ .Boilerplate }}
+
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// ServiceName is the name of the service which is reported with exported spans.
+	ServiceName = "{{ .ServiceName }}"
+
+	// TracerName is the name of the tracer which records the spans of the operator.
+	TracerName = "{{ .Repo }}"
+)
+
+// Setup configures the global tracer provider to export spans to an OTLP gRPC endpoint and
+// returns the function which flushes the remaining spans on shutdown.  Tracing is disabled
+// when the endpoint is empty, in which case spans are not recorded.
+//
+// Tests may install a tracer provider with an in-memory exporter, e.g. from the
+// go.opentelemetry.io/otel/sdk/trace/tracetest package, using otel.SetTracerProvider.
+func Setup(ctx context.Context, endpoint string, insecure bool) (func(context.Context) error, error) {
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(endpoint)}
+	if insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create otlp exporter; %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes(
+			semconv.SchemaURL,
+			semconv.ServiceNameKey.String(ServiceName),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider.Shutdown, nil
+}
+
+// Start starts a span as a child of the span in the context, if any.
+func Start(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return otel.Tracer(TracerName).Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// End records an error on a span, if any, and ends the span.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
+
+// ObjectAttributes returns the span attributes which identify a Kubernetes object.
+func ObjectAttributes(gvk schema.GroupVersionKind, name, namespace string) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("k8s.group", gvk.Group),
+		attribute.String("k8s.version", gvk.Version),
+		attribute.String("k8s.kind", gvk.Kind),
+		attribute.String("k8s.name", name),
+		attribute.String("k8s.namespace", namespace),
+	}
+}
+`
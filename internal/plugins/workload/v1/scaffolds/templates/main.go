@@ -25,6 +25,14 @@ type Main struct {
 	machinery.DomainMixin
 	machinery.RepositoryMixin
 	machinery.ComponentConfigMixin
+
+	// NamespaceScoped determines whether the operator only watches the namespaces
+	// which are set by the --watch-namespace flag.
+	NamespaceScoped bool
+
+	// Tracing determines whether the operator exports traces to the OTLP endpoint
+	// which is set by the --otlp-endpoint flag.
+	Tracing bool
 }
 
 func (f *Main) SetTemplateDefaults() error {
@@ -169,6 +177,9 @@ const mainTemplate = `{{ .Boilerplate }}
 package main
 
 import (
+	{{- if .Tracing }}
+	"context"
+	{{- end }}
 	"flag"
 	"os"
 
@@ -184,6 +195,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
+
+	{{- if .NamespaceScoped }}
+	"{{ .Repo }}/internal/scope"
+	{{- end }}
+	{{- if .Tracing }}
+	"{{ .Repo }}/internal/tracing"
+	{{- end }}
 	%s
 )
 
@@ -223,6 +241,25 @@ func main() {
 		"Omit this flag to use the default configuration values. " +
 		"Command-line flags override configuration from this file.")
 {{- end }}
+{{- if .Tracing }}
+
+	var otlpEndpoint string
+
+	var otlpInsecure bool
+
+	flag.StringVar(&otlpEndpoint, "otlp-endpoint", "",
+		"The OTLP gRPC endpoint to export traces to, e.g. localhost:4317. " +
+		"Tracing is disabled when no endpoint is set.")
+	flag.BoolVar(&otlpInsecure, "otlp-insecure", false, "Disable TLS when exporting traces to the OTLP endpoint.")
+{{- end }}
+{{- if .NamespaceScoped }}
+
+	var watchNamespace string
+
+	flag.StringVar(&watchNamespace, "watch-namespace", "",
+		"The comma-separated namespaces which the controller watches. " +
+		"Defaults to the namespace which the controller runs in.")
+{{- end }}
 
 	opts := zap.Options{
 		Development: true,
@@ -238,18 +275,35 @@ func main() {
 			Deduplicate: true,
 		}),
 	)
+{{- if .NamespaceScoped }}
+
+	if err := scope.SetNamespaces(watchNamespace); err != nil {
+		setupLog.Error(err, "unable to determine the namespaces to watch")
+		os.Exit(1)
+	}
+{{- end }}
+{{- if .Tracing }}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), otlpEndpoint, otlpInsecure)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+{{- end }}
 
 {{ if not .ComponentConfig }}
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
+	options := ctrl.Options{
 		Scheme:                 scheme,
 		MetricsBindAddress:     metricsAddr,
 		Port:                   9443,
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "{{ hashFNV .Repo }}.{{ .Domain }}",
-	})
+	}
 {{- else }}
+	{{- if not .Tracing }}
 	var err error
+	{{- end }}
 	options := ctrl.Options{Scheme: scheme}
 	if configFile != "" {
 		options, err = options.AndFrom(ctrl.ConfigFile().AtPath(configFile))
@@ -258,9 +312,14 @@ func main() {
 			os.Exit(1)
 		}
 	}
+{{- end }}
+{{- if .NamespaceScoped }}
 
-	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
+	// restrict the cache of the manager to the namespaces which are watched
+	scope.ApplyToOptions(&options)
 {{- end }}
+
+	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), options)
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
 		os.Exit(1)
@@ -288,10 +347,23 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
+{{ if .Tracing }}
+	err = mgr.Start(ctrl.SetupSignalHandler())
 
+	// export the remaining spans before exiting
+	if shutdownErr := shutdownTracing(context.Background()); shutdownErr != nil {
+		setupLog.Error(shutdownErr, "unable to shut down tracing")
+	}
+
+	if err != nil {
+		setupLog.Error(err, "problem running manager")
+		os.Exit(1)
+	}
+{{- else }}
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		setupLog.Error(err, "problem running manager")
 		os.Exit(1)
 	}
+{{- end }}
 }
 `
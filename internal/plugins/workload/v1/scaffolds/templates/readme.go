@@ -4,7 +4,12 @@
 package templates
 
 import (
+	"text/template"
+
 	"sigs.k8s.io/kubebuilder/v3/pkg/machinery"
+
+	"github.com/vmware-tanzu-labs/operator-builder/internal/utils"
+	workloadv1 "github.com/vmware-tanzu-labs/operator-builder/internal/workload/v1"
 )
 
 var _ machinery.Template = &Readme{}
@@ -13,7 +18,8 @@ var _ machinery.Template = &Readme{}
 type Readme struct {
 	machinery.TemplateMixin
 
-	RootCmd string
+	RootCmd    string
+	Components []*workloadv1.ComponentWorkload
 }
 
 // SetTemplateDefaults implements file.Template.
@@ -28,6 +34,10 @@ func (f *Readme) SetTemplateDefaults() error {
 	return nil
 }
 
+func (*Readme) GetFuncMap() template.FuncMap {
+	return utils.JoinStringsHelper()
+}
+
 const readmefileTemplate = `A Kubernetes operator built with
 [operator-builder](https://github.com/vmware-tanzu-labs/operator-builder).
 
@@ -69,6 +79,17 @@ To clean up:
 
     make undeploy
 
+{{ if .Components -}}
+## Components
+
+The components of this collection are installed in the following order.  Each
+component waits for the components it depends upon before its resources are
+created:
+
+{{ range .Components -}}
+1. {{ .Spec.API.Kind }} ({{ .Name }}){{ if .Spec.Dependencies }} - depends on: {{ joinStrings .Spec.Dependencies ", " }}{{ end }}
+{{ end }}
+{{ end -}}
 {{ if ne .RootCmd "" -}}
 ## Companion CLI
 
@@ -14,6 +14,7 @@ const (
 	quoteStringFuncName    = "quoteString"
 	removeStringFuncName   = "removeString"
 	containsStringFuncName = "containsString"
+	joinStringsFuncName    = "joinStrings"
 )
 
 // QuoteStringHelper returns the function map for quoting strings in templates.
@@ -55,12 +56,24 @@ func ContainsStringHelper() template.FuncMap {
 	return funcMap
 }
 
+// JoinStringsHelper returns the function map for joining a list of strings
+// with a separator in templates.
+func JoinStringsHelper() template.FuncMap {
+	funcMap := machinery.DefaultFuncMap()
+	funcMap[joinStringsFuncName] = func(values []string, separator string) string {
+		return strings.Join(values, separator)
+	}
+
+	return funcMap
+}
+
 // TemplateHelpers returns all of the template helpers in the utils package.
 func TemplateHelpers() template.FuncMap {
 	funcMap := machinery.DefaultFuncMap()
 	funcMap[quoteStringFuncName] = QuoteStringHelper()[quoteStringFuncName]
 	funcMap[removeStringFuncName] = RemoveStringHelper()[removeStringFuncName]
 	funcMap[containsStringFuncName] = ContainsStringHelper()[containsStringFuncName]
+	funcMap[joinStringsFuncName] = JoinStringsHelper()[joinStringsFuncName]
 
 	return funcMap
 }
@@ -6,6 +6,7 @@ package v1
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"sigs.k8s.io/kubebuilder/v3/pkg/model/resource"
 
@@ -133,6 +134,25 @@ func (c *WorkloadCollection) IsClusterScoped() bool {
 	return c.Spec.API.ClusterScoped
 }
 
+func (c *WorkloadCollection) IsNamespaceScoped() bool {
+	// all workloads of a project are reconciled by the same operator, so the
+	// operator scope of the root collection applies
+	if c.Spec.Collection != nil {
+		return c.Spec.Collection.IsNamespaceScoped()
+	}
+
+	return c.Spec.OperatorScope == OperatorScopeNamespace
+}
+
+func (c *WorkloadCollection) IsTracingEnabled() bool {
+	// tracing is set up by the operator, so the setting of the root collection applies
+	if c.Spec.Collection != nil {
+		return c.Spec.Collection.IsTracingEnabled()
+	}
+
+	return c.Spec.Tracing
+}
+
 func (c *WorkloadCollection) IsStandalone() bool {
 	return false
 }
@@ -146,23 +166,35 @@ func (c *WorkloadCollection) IsCollection() bool {
 }
 
 func (c *WorkloadCollection) SetResources(workloadPath string) error {
-	resources, err := processMarkers(workloadPath, c.Spec.Resources, true, true)
+	// the resources of a nested collection are processed in the same manner as
+	// the resources of a component, as the collection markers within them
+	// refer to the collections in which it is nested
+	resources, err := processMarkers(
+		workloadPath,
+		c.Spec.Resources,
+		!c.isNested(),
+		!c.isNested(),
+		c.Spec.Collection,
+	)
 	if err != nil {
 		return err
 	}
 
 	c.Spec.SourceFiles = *resources.SourceFiles
 	c.Spec.RBACRules = *resources.RBACRules
+	c.Spec.RBAC.addTo(&c.Spec.RBACRules)
+	rbacRulesCollapse(&c.Spec.RBACRules)
 	c.Spec.OwnershipRules = *resources.OwnershipRules
 
 	specFields := resources.SpecFields
 
-	for _, component := range c.Spec.Components {
+	for _, child := range c.getChildManifests() {
 		componentResources, err := processMarkers(
-			component.Spec.ConfigPath,
-			component.Spec.Resources,
+			child.configPath,
+			child.resources,
 			true,
 			false,
+			c,
 		)
 		if err != nil {
 			return err
@@ -193,6 +225,46 @@ func (c *WorkloadCollection) SetResources(workloadPath string) error {
 	return nil
 }
 
+// childManifests represents the resource manifests of a workload which is a
+// direct child of a collection.
+type childManifests struct {
+	configPath string
+	resources  []string
+}
+
+// getChildManifests returns the resource manifests for the direct children
+// of the collection.  Components of nested collections are excluded as the
+// collection markers within them refer to the nested collection or to the
+// collections above it.
+func (c *WorkloadCollection) getChildManifests() []childManifests {
+	children := []childManifests{}
+
+	for _, component := range c.Spec.Components {
+		if component.Spec.Collection != c {
+			continue
+		}
+
+		children = append(children, childManifests{
+			configPath: component.Spec.ConfigPath,
+			resources:  component.Spec.Resources,
+		})
+	}
+
+	for _, collection := range c.Spec.Collections {
+		children = append(children, childManifests{
+			configPath: collection.Spec.ConfigPath,
+			resources:  collection.Spec.Resources,
+		})
+	}
+
+	return children
+}
+
+// isNested returns whether the collection is nested within another collection.
+func (c *WorkloadCollection) isNested() bool {
+	return c.Spec.Collection != nil
+}
+
 func (c *WorkloadCollection) GetDependencies() []*ComponentWorkload {
 	return []*ComponentWorkload{}
 }
@@ -204,19 +276,299 @@ func (c *WorkloadCollection) SetComponents(components []*ComponentWorkload) erro
 }
 
 func (c *WorkloadCollection) HasChildResources() bool {
-	return len(c.Spec.Resources) > 0
+	return len(c.Spec.Resources) > 0 || len(c.GetManagedComponents()) > 0
+}
+
+// ManagedComponent is a component or nested collection whose custom resource is
+// created by its collection.
+type ManagedComponent struct {
+	WorkloadAPIBuilder
+
+	// FieldName is the name of the field within the components of the
+	// collection spec which configures the component, e.g. "ingressComponent".
+	FieldName string
+
+	// CreateFuncName is the name of the function which creates the custom
+	// resource of the component from the collection.
+	CreateFuncName string
+
+	// InheritedFields are the spec fields of the component which are taken
+	// from the field of the same name and type of the collection spec unless
+	// they are set for the component.
+	InheritedFields []*APISpecField
+}
+
+// GetManagedComponents returns the direct children of the collection whose custom
+// resources are created by the collection.  No children are returned unless the
+// collection manages its components.
+func (c *WorkloadCollection) GetManagedComponents() []*ManagedComponent {
+	managed := []*ManagedComponent{}
+
+	if !c.Spec.ManageComponents {
+		return managed
+	}
+
+	for _, child := range c.GetChildren() {
+		kind := child.GetAPIKind()
+
+		managed = append(managed, &ManagedComponent{
+			WorkloadAPIBuilder: child,
+			FieldName:          strings.ToLower(kind[:1]) + kind[1:],
+			CreateFuncName:     fmt.Sprintf("CreateComponent%s", kind),
+			InheritedFields:    c.getInheritedFields(child),
+		})
+	}
+
+	return managed
+}
+
+// GetChildren returns the direct children of the collection, which are the
+// components and nested collections which belong to it.  Components of nested
+// collections are excluded.
+func (c *WorkloadCollection) GetChildren() []WorkloadAPIBuilder {
+	children := []WorkloadAPIBuilder{}
+
+	for _, component := range c.Spec.Components {
+		if component.Spec.Collection == c {
+			children = append(children, component)
+		}
+	}
+
+	for _, collection := range c.Spec.Collections {
+		children = append(children, collection)
+	}
+
+	return children
+}
+
+// GetAPIImports returns the import paths of the API packages of workloads in a
+// repository, keyed by their import alias.  Packages whose alias is imported
+// already are excluded.
+func GetAPIImports(repo string, workloads []WorkloadAPIBuilder, imported ...string) map[string]string {
+	imports := map[string]string{}
+
+	for _, workload := range workloads {
+		imports[workload.GetAPIGroup()+workload.GetAPIVersion()] = fmt.Sprintf(
+			"%s/apis/%s/%s",
+			repo,
+			workload.GetAPIGroup(),
+			workload.GetAPIVersion(),
+		)
+	}
+
+	for _, alias := range imported {
+		delete(imports, alias)
+	}
+
+	return imports
+}
+
+// GetAncestorImports maps the import aliases of the API packages of the
+// ancestors of a collection, which are the collections above it, to their import
+// paths.  Imports are not returned for aliases which are imported already.
+func GetAncestorImports(repo string, collection *WorkloadCollection, imported ...string) map[string]string {
+	ancestors := []WorkloadAPIBuilder{}
+
+	for _, ancestor := range collection.GetAncestors() {
+		ancestors = append(ancestors, ancestor)
+	}
+
+	return GetAPIImports(repo, ancestors, imported...)
+}
+
+// getInheritedFields returns the spec fields of a child which are also spec fields
+// of the collection.
+func (c *WorkloadCollection) getInheritedFields(child WorkloadAPIBuilder) []*APISpecField {
+	inherited := []*APISpecField{}
+
+	for _, field := range child.GetAPISpecFields() {
+		for _, collectionField := range c.Spec.APISpecFields {
+			if field.ManifestFieldName == collectionField.ManifestFieldName && field.DataType == collectionField.DataType {
+				inherited = append(inherited, field)
+
+				break
+			}
+		}
+	}
+
+	return inherited
 }
 
 func (c *WorkloadCollection) GetComponents() []*ComponentWorkload {
 	return c.Spec.Components
 }
 
+func (c *WorkloadCollection) GetCollection() *WorkloadCollection {
+	return c.Spec.Collection
+}
+
+// GetCollections returns all of the collections nested within the collection,
+// including those nested within other nested collections.  A nested collection
+// is always returned before the collections nested within it.
+func (c *WorkloadCollection) GetCollections() []*WorkloadCollection {
+	collections := []*WorkloadCollection{}
+
+	for _, collection := range c.Spec.Collections {
+		collections = append(collections, collection)
+		collections = append(collections, collection.GetCollections()...)
+	}
+
+	return collections
+}
+
+// GetAncestors returns the collections in which the collection is nested, from
+// the collection in which it is directly nested up to the root collection.
+func (c *WorkloadCollection) GetAncestors() []*WorkloadCollection {
+	ancestors := []*WorkloadCollection{}
+
+	for ancestor := c.Spec.Collection; ancestor != nil; ancestor = ancestor.Spec.Collection {
+		ancestors = append(ancestors, ancestor)
+	}
+
+	return ancestors
+}
+
+// GetVarName returns the name of the variable which holds the custom resource
+// of the collection in the code of the workloads which are nested below it,
+// but not directly within it.
+func (c *WorkloadCollection) GetVarName() string {
+	return strings.ToLower(c.Spec.API.Kind[:1]) + c.Spec.API.Kind[1:]
+}
+
+// resolveCollectionField returns the collection against which a collection
+// marker in the resources of a workload which is directly nested in a
+// collection resolves.  This is the nearest ancestor of the workload which
+// defines the field, which is the collection itself if the markers in its own
+// resources define it, or otherwise the nearest collection above it with the
+// field.  The field is added to the collection itself if no ancestor defines it.
+func resolveCollectionField(collection *WorkloadCollection, name string) (*WorkloadCollection, error) {
+	if collection == nil {
+		return nil, nil
+	}
+
+	fields, err := collection.ownFieldNames()
+	if err != nil {
+		return nil, err
+	}
+
+	if fields[name] {
+		return collection, nil
+	}
+
+	for _, ancestor := range collection.GetAncestors() {
+		fields, err := ancestor.fieldNames()
+		if err != nil {
+			return nil, err
+		}
+
+		if fields[name] {
+			return ancestor, nil
+		}
+	}
+
+	return collection, nil
+}
+
+// ownFieldNames returns the names of the fields which the markers in the own
+// resources of the collection define.  The collection markers in the resources
+// of a nested collection refer to the collections above it rather than to the
+// nested collection, so they only define fields of the root collection.
+func (c *WorkloadCollection) ownFieldNames() (map[string]bool, error) {
+	fields, collectionFields, err := markerFieldNames(c.Spec.ConfigPath, c.Spec.Resources)
+	if err != nil {
+		return nil, err
+	}
+
+	if !c.isNested() {
+		for name := range collectionFields {
+			fields[name] = true
+		}
+	}
+
+	return fields, nil
+}
+
+// fieldNames returns the names of the fields of the collection, which are
+// defined by the markers in its own resources and by the collection markers
+// in the resources of its direct children which resolve against it.
+func (c *WorkloadCollection) fieldNames() (map[string]bool, error) {
+	fields, err := c.ownFieldNames()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, child := range c.getChildManifests() {
+		_, collectionFields, err := markerFieldNames(child.configPath, child.resources)
+		if err != nil {
+			return nil, err
+		}
+
+		for name := range collectionFields {
+			if fields[name] {
+				continue
+			}
+
+			owner, err := resolveCollectionField(c, name)
+			if err != nil {
+				return nil, err
+			}
+
+			fields[name] = owner == c
+		}
+	}
+
+	return fields, nil
+}
+
 func (c *WorkloadCollection) GetSourceFiles() *[]SourceFile {
 	return &c.Spec.SourceFiles
 }
 
+// GetFuncNames returns the names of the create and init functions of the child
+// resources.  The custom resources of managed components are created after the
+// child resources of the collection.
 func (c *WorkloadCollection) GetFuncNames() (createFuncNames, initFuncNames []string) {
-	return getFuncNames(*c.GetSourceFiles())
+	createFuncNames, initFuncNames = getFuncNames(*c.GetSourceFiles())
+
+	for _, component := range c.GetManagedComponents() {
+		createFuncNames = append(createFuncNames, component.CreateFuncName)
+	}
+
+	return createFuncNames, initFuncNames
+}
+
+// GetReadyFuncNames returns the names of the ready functions of the child resources.
+// The readiness of a managed component is determined by its status conditions.
+func (c *WorkloadCollection) GetReadyFuncNames() []string {
+	readyFuncNames := getReadyFuncNames(*c.GetSourceFiles())
+
+	for range c.GetManagedComponents() {
+		readyFuncNames = append(readyFuncNames, "")
+	}
+
+	return readyFuncNames
+}
+
+func (c *WorkloadCollection) GetResourceWaves() []int {
+	waves := getResourceWaves(*c.GetSourceFiles())
+
+	for range c.GetManagedComponents() {
+		waves = append(waves, WaveWorkload)
+	}
+
+	return waves
+}
+
+// GetResourceRecreatePolicies returns the recreate policies of the resource markers of the child
+// resources.  Managed components have no recreate policy of their own.
+func (c *WorkloadCollection) GetResourceRecreatePolicies() []string {
+	policies := getResourceRecreatePolicies(*c.GetSourceFiles())
+
+	for range c.GetManagedComponents() {
+		policies = append(policies, "")
+	}
+
+	return policies
 }
 
 func (c *WorkloadCollection) GetAPISpecFields() []*APISpecField {
@@ -231,6 +583,16 @@ func (*WorkloadCollection) GetOwnershipRules() *[]OwnershipRule {
 	return &[]OwnershipRule{}
 }
 
+func (c *WorkloadCollection) GetPersistence() *PersistenceConfig {
+	// child resources of all workloads in a project are persisted by the same
+	// generated code, so the persistence of the root collection applies
+	if c.Spec.Collection != nil {
+		return c.Spec.Collection.GetPersistence()
+	}
+
+	return &c.Spec.Persistence
+}
+
 func (c *WorkloadCollection) GetComponentResource(domain, repo string, clusterScoped bool) *resource.Resource {
 	var namespaced bool
 	if clusterScoped {
@@ -266,6 +628,17 @@ func (c *WorkloadCollection) GetComponentResource(domain, repo string, clusterSc
 func (c *WorkloadCollection) SetNames() {
 	c.PackageName = utils.ToPackageName(c.Name)
 
+	// a nested collection is managed by a subcommand of the root command in
+	// the same manner as a component
+	if c.isNested() {
+		c.Spec.CompanionCliSubcmd.setSubCommandValues(
+			c.Spec.API.Kind,
+			defaultCollectionSubcommandDescription,
+		)
+
+		return
+	}
+
 	// only set the names if we have specified the root command name else none
 	// of the following values will matter as the code for the cli will not be
 	// generated
@@ -299,6 +672,13 @@ func (c *WorkloadCollection) GetSubcommands() *[]CliCommand {
 		commands = append(commands, c.Spec.CompanionCliSubcmd)
 	}
 
+	// nested collections follow the same rule as the collection itself
+	for _, collection := range c.GetCollections() {
+		if collection.HasChildResources() {
+			commands = append(commands, collection.Spec.CompanionCliSubcmd)
+		}
+	}
+
 	for _, comp := range c.Spec.Components {
 		if comp.HasSubCmdName() {
 			commands = append(commands, comp.Spec.CompanionCliSubcmd)
@@ -198,3 +198,105 @@ func Test_CollectionSetNames(t *testing.T) {
 		})
 	}
 }
+
+func Test_CollectionGetManagedComponents(t *testing.T) {
+	t.Parallel()
+
+	collection := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{
+			API: APISpec{Kind: "Platform"},
+		},
+	}
+
+	nested := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{
+			API:        APISpec{Kind: "TenancyPlatform"},
+			Collection: collection,
+		},
+	}
+
+	ingress := &ComponentWorkload{
+		Spec: ComponentWorkloadSpec{
+			API:        APISpec{Kind: "IngressComponent"},
+			Collection: collection,
+		},
+	}
+
+	metrics := &ComponentWorkload{
+		Spec: ComponentWorkloadSpec{
+			API:        APISpec{Kind: "MetricsComponent"},
+			Collection: nested,
+		},
+	}
+
+	collection.Spec.APISpecFields = []*APISpecField{
+		{ManifestFieldName: "domain", DataType: "string"},
+		{ManifestFieldName: "replicas", DataType: "string"},
+	}
+
+	ingress.Spec.APISpecFields = []*APISpecField{
+		{ManifestFieldName: "domain", DataType: "string"},
+		{ManifestFieldName: "replicas", DataType: "int"},
+		{ManifestFieldName: "image", DataType: "string"},
+	}
+
+	collection.Spec.Components = []*ComponentWorkload{ingress, metrics}
+	collection.Spec.Collections = []*WorkloadCollection{nested}
+
+	assert.Equal(t, []WorkloadAPIBuilder{ingress, nested}, collection.GetChildren())
+	assert.Empty(t, collection.GetManagedComponents())
+	assert.False(t, collection.HasChildResources())
+
+	collection.Spec.ManageComponents = true
+
+	managed := collection.GetManagedComponents()
+	if assert.Len(t, managed, 2) {
+		assert.Equal(t, ingress, managed[0].WorkloadAPIBuilder)
+		assert.Equal(t, "ingressComponent", managed[0].FieldName)
+		assert.Equal(t, "CreateComponentIngressComponent", managed[0].CreateFuncName)
+		assert.Equal(t, ingress.Spec.APISpecFields[:1], managed[0].InheritedFields)
+		assert.Equal(t, nested, managed[1].WorkloadAPIBuilder)
+		assert.Equal(t, "tenancyPlatform", managed[1].FieldName)
+	}
+
+	createFuncNames, _ := collection.GetFuncNames()
+	assert.Equal(t, []string{"CreateComponentIngressComponent", "CreateComponentTenancyPlatform"}, createFuncNames)
+	assert.Equal(t, []string{"", ""}, collection.GetReadyFuncNames())
+	assert.Equal(t, []int{WaveWorkload, WaveWorkload}, collection.GetResourceWaves())
+	assert.True(t, collection.HasChildResources())
+}
+
+func Test_GetAPIImports(t *testing.T) {
+	t.Parallel()
+
+	workloads := []WorkloadAPIBuilder{
+		&ComponentWorkload{Spec: ComponentWorkloadSpec{API: APISpec{Group: "network", Version: "v1alpha1"}}},
+		&ComponentWorkload{Spec: ComponentWorkloadSpec{API: APISpec{Group: "network", Version: "v1alpha1"}}},
+		&ComponentWorkload{Spec: ComponentWorkloadSpec{API: APISpec{Group: "platform", Version: "v1alpha1"}}},
+		&WorkloadCollection{Spec: WorkloadCollectionSpec{API: APISpec{Group: "tenancy", Version: "v1beta1"}}},
+	}
+
+	assert.Equal(t, map[string]string{
+		"networkv1alpha1": "github.com/acme/platform/apis/network/v1alpha1",
+		"tenancyv1beta1":  "github.com/acme/platform/apis/tenancy/v1beta1",
+	}, GetAPIImports("github.com/acme/platform", workloads, "platformv1alpha1"))
+}
+
+func Test_CollectionIsTracingEnabled(t *testing.T) {
+	t.Parallel()
+
+	root := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{Tracing: true},
+	}
+
+	nested := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{Collection: root},
+	}
+
+	component := &ComponentWorkload{Spec: ComponentWorkloadSpec{Collection: nested}}
+
+	assert.True(t, nested.IsTracingEnabled())
+	assert.True(t, component.IsTracingEnabled())
+	assert.False(t, (&ComponentWorkload{}).IsTracingEnabled())
+	assert.False(t, (&StandaloneWorkload{}).IsTracingEnabled())
+}
@@ -104,6 +104,22 @@ func (c *ComponentWorkload) IsClusterScoped() bool {
 	return c.Spec.API.ClusterScoped
 }
 
+func (c *ComponentWorkload) IsNamespaceScoped() bool {
+	if c.Spec.Collection == nil {
+		return false
+	}
+
+	return c.Spec.Collection.IsNamespaceScoped()
+}
+
+func (c *ComponentWorkload) IsTracingEnabled() bool {
+	if c.Spec.Collection == nil {
+		return false
+	}
+
+	return c.Spec.Collection.IsTracingEnabled()
+}
+
 func (*ComponentWorkload) IsStandalone() bool {
 	return false
 }
@@ -117,7 +133,7 @@ func (*ComponentWorkload) IsCollection() bool {
 }
 
 func (c *ComponentWorkload) SetResources(workloadPath string) error {
-	resources, err := processMarkers(workloadPath, c.Spec.Resources, false, false)
+	resources, err := processMarkers(workloadPath, c.Spec.Resources, false, false, c.Spec.Collection)
 	if err != nil {
 		return err
 	}
@@ -125,6 +141,8 @@ func (c *ComponentWorkload) SetResources(workloadPath string) error {
 	c.Spec.APISpecFields = resources.SpecFields
 	c.Spec.SourceFiles = *resources.SourceFiles
 	c.Spec.RBACRules = *resources.RBACRules
+	c.Spec.RBAC.addTo(&c.Spec.RBACRules)
+	rbacRulesCollapse(&c.Spec.RBACRules)
 	c.Spec.OwnershipRules = *resources.OwnershipRules
 
 	return nil
@@ -146,6 +164,34 @@ func (*ComponentWorkload) GetComponents() []*ComponentWorkload {
 	return []*ComponentWorkload{}
 }
 
+func (c *ComponentWorkload) GetCollection() *WorkloadCollection {
+	return c.Spec.Collection
+}
+
+func (*ComponentWorkload) GetCollections() []*WorkloadCollection {
+	return []*WorkloadCollection{}
+}
+
+func (*ComponentWorkload) GetManagedComponents() []*ManagedComponent {
+	return []*ManagedComponent{}
+}
+
+func (*ComponentWorkload) GetChildren() []WorkloadAPIBuilder {
+	return []WorkloadAPIBuilder{}
+}
+
+// BelongsTo returns whether the component is part of a collection, either
+// directly or through a collection nested within it.
+func (c *ComponentWorkload) BelongsTo(collection *WorkloadCollection) bool {
+	for parent := c.Spec.Collection; parent != nil; parent = parent.Spec.Collection {
+		if parent == collection {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *ComponentWorkload) GetSourceFiles() *[]SourceFile {
 	return &c.Spec.SourceFiles
 }
@@ -154,6 +200,18 @@ func (c *ComponentWorkload) GetFuncNames() (createFuncNames, initFuncNames []str
 	return getFuncNames(*c.GetSourceFiles())
 }
 
+func (c *ComponentWorkload) GetReadyFuncNames() []string {
+	return getReadyFuncNames(*c.GetSourceFiles())
+}
+
+func (c *ComponentWorkload) GetResourceWaves() []int {
+	return getResourceWaves(*c.GetSourceFiles())
+}
+
+func (c *ComponentWorkload) GetResourceRecreatePolicies() []string {
+	return getResourceRecreatePolicies(*c.GetSourceFiles())
+}
+
 func (c *ComponentWorkload) GetAPISpecFields() []*APISpecField {
 	return c.Spec.APISpecFields
 }
@@ -166,6 +224,14 @@ func (c *ComponentWorkload) GetOwnershipRules() *[]OwnershipRule {
 	return &c.Spec.OwnershipRules
 }
 
+func (c *ComponentWorkload) GetPersistence() *PersistenceConfig {
+	if c.Spec.Collection == nil {
+		return &PersistenceConfig{}
+	}
+
+	return c.Spec.Collection.GetPersistence()
+}
+
 func (c *ComponentWorkload) GetComponentResource(domain, repo string, clusterScoped bool) *resource.Resource {
 	var namespaced bool
 	if clusterScoped {
@@ -8,7 +8,9 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"path/filepath"
+	"strings"
 
 	"github.com/go-playground/validator"
 	"gopkg.in/yaml.v3"
@@ -22,6 +24,7 @@ var (
 	ErrCollectionRequired  = errors.New("a WorkloadCollection is required when using WorkloadComponents")
 	ErrMissingWorkload     = errors.New("could not find either standalone or collection workload, please provide one")
 	ErrMissingDependencies = errors.New("missing dependencies - no workload config provided")
+	ErrCircularDependency  = errors.New("circular dependency detected between components")
 )
 
 func ProcessInitConfig(workloadConfig string) (WorkloadInitializer, error) {
@@ -36,6 +39,8 @@ func ProcessInitConfig(workloadConfig string) (WorkloadInitializer, error) {
 
 	var workload WorkloadInitializer
 
+	var components []*ComponentWorkload
+
 	for k := range workloads {
 		for _, w := range workloads[k] {
 			switch v := w.(type) {
@@ -44,11 +49,23 @@ func ProcessInitConfig(workloadConfig string) (WorkloadInitializer, error) {
 			case *WorkloadCollection:
 				workload = v
 			case *ComponentWorkload:
-				continue
+				components = append(components, v)
 			}
 		}
 	}
 
+	// resolve the dependencies at initialization time so that dependency
+	// errors are surfaced early and the install order is known
+	if err := handleDependencies(&components); err != nil {
+		return nil, err
+	}
+
+	if len(workloads[WorkloadKindCollection]) == 1 {
+		if err := workload.SetComponents(components); err != nil {
+			return nil, fmt.Errorf("%w", err)
+		}
+	}
+
 	workload.SetNames()
 
 	return workload, nil
@@ -87,10 +104,6 @@ func ProcessAPIConfig(workloadConfig string) (WorkloadAPIBuilder, error) {
 
 				workload.SetNames()
 			case *ComponentWorkload:
-				if err := v.SetResources(v.Spec.ConfigPath); err != nil {
-					return nil, err
-				}
-
 				v.SetNames()
 				components = append(components, v)
 			}
@@ -106,6 +119,19 @@ func ProcessAPIConfig(workloadConfig string) (WorkloadAPIBuilder, error) {
 			return nil, fmt.Errorf("%w", err)
 		}
 
+		if err := setNestedCollections(workload.GetCollections(), components); err != nil {
+			return nil, err
+		}
+
+		// the resources of the components are set once all of the collections
+		// know their components, as the collection markers within them resolve
+		// against the fields which the components define for their collections
+		for _, component := range components {
+			if err := component.SetResources(component.Spec.ConfigPath); err != nil {
+				return nil, err
+			}
+		}
+
 		if err := workload.SetResources(workloadConfig); err != nil {
 			return nil, fmt.Errorf("%w", err)
 		}
@@ -114,6 +140,35 @@ func ProcessAPIConfig(workloadConfig string) (WorkloadAPIBuilder, error) {
 	return workload, nil
 }
 
+// setNestedCollections sets the components, resources and names for each of
+// the collections which are nested within another collection.  The components
+// are expected to be in install order and only those which belong to a nested
+// collection, either directly or through another nested collection, are set
+// on it.
+func setNestedCollections(collections []*WorkloadCollection, components []*ComponentWorkload) error {
+	for _, collection := range collections {
+		var nestedComponents []*ComponentWorkload
+
+		for _, component := range components {
+			if component.BelongsTo(collection) {
+				nestedComponents = append(nestedComponents, component)
+			}
+		}
+
+		if err := collection.SetComponents(nestedComponents); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		if err := collection.SetResources(collection.Spec.ConfigPath); err != nil {
+			return fmt.Errorf("%w", err)
+		}
+
+		collection.SetNames()
+	}
+
+	return nil
+}
+
 func missingDependencies(expected, actual []string) []string {
 	var missing []string
 
@@ -148,8 +203,18 @@ func parseConfig(workloadConfig string) (map[WorkloadKind][]WorkloadIdentifier,
 
 	defer CloseFile(file)
 
+	content, err := ioutil.ReadAll(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", workloadConfig, err)
+	}
+
+	content, err = interpolateEnv(content)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s: %w", workloadConfig, err)
+	}
+
 	var kindReader bytes.Buffer
-	reader := io.TeeReader(file, &kindReader)
+	reader := io.TeeReader(bytes.NewReader(content), &kindReader)
 
 	sharedDecoder := yaml.NewDecoder(reader)
 
@@ -187,6 +252,8 @@ func parseConfig(workloadConfig string) (map[WorkloadKind][]WorkloadIdentifier,
 		workloads[workload.GetWorkloadKind()] = append(workloads[workload.GetWorkloadKind()], workload)
 
 		if collection, ok := workload.(*WorkloadCollection); ok {
+			collection.Spec.ConfigPath = workloadConfig
+
 			cws, err := parseCollectionComponents(collection, workloadConfig)
 			if err != nil {
 				return nil, err
@@ -216,15 +283,51 @@ func parseCollectionComponents(workload *WorkloadCollection, workloadConfig stri
 
 		for _, component := range w[WorkloadKindComponent] {
 			if cw, ok := component.(*ComponentWorkload); ok {
-				cw.Spec.ConfigPath = componentPath
+				// components of a nested collection have already been
+				// assigned to the nested collection, which is their nearest
+				// ancestor
+				if cw.Spec.Collection == nil {
+					cw.Spec.ConfigPath = componentPath
+					cw.Spec.Collection = workload
+				}
+
+				// the defaults of a nested collection have already been
+				// applied at this point, so they take precedence
+				workload.Spec.Defaults.apply(&cw.Spec.API)
+
 				workloads = append(workloads, cw)
 			}
 		}
+
+		for _, collection := range w[WorkloadKindCollection] {
+			if cw, ok := collection.(*WorkloadCollection); ok {
+				workload.Spec.Defaults.apply(&cw.Spec.API)
+
+				cw.Spec.ConfigPath = componentPath
+				cw.Spec.Collection = workload
+				workload.Spec.Collections = append(workload.Spec.Collections, cw)
+			}
+		}
 	}
 
 	return workloads, nil
 }
 
+// apply sets the API attributes which are not set from the defaults.
+func (defaults *WorkloadDefaults) apply(api *APISpec) {
+	if api.Domain == "" {
+		api.Domain = defaults.Domain
+	}
+
+	if api.Group == "" {
+		api.Group = defaults.Group
+	}
+
+	if api.Version == "" {
+		api.Version = defaults.Version
+	}
+}
+
 func decodeKind(kind WorkloadKind, dc *yaml.Decoder) (WorkloadIdentifier, error) {
 	switch kind {
 	case WorkloadKindStandalone:
@@ -295,11 +398,84 @@ func handleDependencies(components *[]*ComponentWorkload) error {
 		}
 	}
 
-	*components = c
+	// sort the components so that each component is installed after the
+	// components that it depends upon
+	ordered, err := sortByDependencies(c)
+	if err != nil {
+		return err
+	}
+
+	*components = ordered
 
 	return nil
 }
 
+// sortByDependencies returns the components in the order in which they must be
+// installed, such that every component appears after all of its dependencies.
+// Components without a dependency relationship retain the order in which they
+// were listed in the workload config.  An error is returned which includes the
+// dependency path if a circular dependency is found.
+func sortByDependencies(components []*ComponentWorkload) ([]*ComponentWorkload, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+
+	state := make(map[*ComponentWorkload]int, len(components))
+	ordered := make([]*ComponentWorkload, 0, len(components))
+
+	// path tracks the current chain of dependencies being visited so that it
+	// may be reported back to the user if a cycle is found
+	var path []string
+
+	var visit func(component *ComponentWorkload) error
+
+	visit = func(component *ComponentWorkload) error {
+		path = append(path, component.Name)
+
+		switch state[component] {
+		case visited:
+			path = path[:len(path)-1]
+
+			return nil
+		case visiting:
+			// trim the path to the start of the cycle for a clearer message
+			for i := range path {
+				if path[i] == component.Name {
+					path = path[i:]
+
+					break
+				}
+			}
+
+			return fmt.Errorf("%w: %s", ErrCircularDependency, strings.Join(path, " -> "))
+		}
+
+		state[component] = visiting
+
+		for _, dependency := range component.Spec.ComponentDependencies {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+
+		state[component] = visited
+		ordered = append(ordered, component)
+		path = path[:len(path)-1]
+
+		return nil
+	}
+
+	for _, component := range components {
+		if err := visit(component); err != nil {
+			return nil, err
+		}
+	}
+
+	return ordered, nil
+}
+
 func validateConfigs(workloads map[WorkloadKind][]WorkloadIdentifier) error {
 	validate := validator.New()
 
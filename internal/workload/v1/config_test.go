@@ -0,0 +1,313 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newTestComponent(name string, dependencies ...string) *ComponentWorkload {
+	return &ComponentWorkload{
+		WorkloadShared: WorkloadShared{
+			Name: name,
+			Kind: WorkloadKindComponent,
+		},
+		Spec: ComponentWorkloadSpec{
+			Dependencies: dependencies,
+		},
+	}
+}
+
+func componentNames(components []*ComponentWorkload) []string {
+	names := make([]string, len(components))
+	for i := range components {
+		names[i] = components[i].Name
+	}
+
+	return names
+}
+
+func Test_handleDependencies(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		input    []*ComponentWorkload
+		expected []string
+		err      error
+		errMsg   string
+	}{
+		{
+			name: "components without dependencies retain their order",
+			input: []*ComponentWorkload{
+				newTestComponent("a"),
+				newTestComponent("b"),
+				newTestComponent("c"),
+			},
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name: "components are ordered after their dependencies",
+			input: []*ComponentWorkload{
+				newTestComponent("a", "c"),
+				newTestComponent("b"),
+				newTestComponent("c", "b"),
+				newTestComponent("d", "a", "b"),
+			},
+			expected: []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "missing dependency",
+			input: []*ComponentWorkload{
+				newTestComponent("a", "missing"),
+			},
+			err: ErrMissingDependencies,
+		},
+		{
+			name: "self dependency",
+			input: []*ComponentWorkload{
+				newTestComponent("a", "a"),
+			},
+			err:    ErrCircularDependency,
+			errMsg: "a -> a",
+		},
+		{
+			name: "circular dependency",
+			input: []*ComponentWorkload{
+				newTestComponent("a", "b"),
+				newTestComponent("b", "c"),
+				newTestComponent("c", "b"),
+			},
+			err:    ErrCircularDependency,
+			errMsg: "b -> c -> b",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			components := tt.input
+			err := handleDependencies(&components)
+
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+				assert.Contains(t, err.Error(), tt.errMsg)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, componentNames(components))
+		})
+	}
+}
+
+func writeTestConfigs(t *testing.T, configs map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+
+	for name, content := range configs {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func Test_ProcessAPIConfigNestedCollections(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestConfigs(t, map[string]string{
+		"platform.yaml": `
+name: platform
+kind: WorkloadCollection
+spec:
+  api:
+    domain: acme.com
+    group: platform
+    version: v1alpha1
+    kind: Platform
+  defaults:
+    group: platform
+    version: v1alpha1
+  componentFiles:
+    - observability.yaml
+    - ingress.yaml
+`,
+		"observability.yaml": `
+name: observability
+kind: WorkloadCollection
+spec:
+  api:
+    group: observability
+    kind: Observability
+  defaults:
+    group: observability
+  componentFiles:
+    - metrics.yaml
+`,
+		"ingress.yaml": `
+name: ingress
+kind: ComponentWorkload
+spec:
+  api:
+    kind: Ingress
+`,
+		"metrics.yaml": `
+name: metrics
+kind: ComponentWorkload
+spec:
+  api:
+    kind: Metrics
+  dependencies:
+    - ingress
+`,
+	})
+
+	workload, err := ProcessAPIConfig(filepath.Join(dir, "platform.yaml"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	collections := workload.GetCollections()
+	if !assert.Len(t, collections, 1) {
+		return
+	}
+
+	nested := collections[0]
+	assert.Equal(t, "observability", nested.Name)
+	assert.Equal(t, workload, nested.GetCollection())
+	assert.Equal(t, []string{"ingress", "metrics"}, componentNames(workload.GetComponents()))
+	assert.Equal(t, []string{"metrics"}, componentNames(nested.GetComponents()))
+
+	metrics := nested.GetComponents()[0]
+	assert.Equal(t, nested, metrics.GetCollection())
+	assert.True(t, metrics.BelongsTo(nested))
+	assert.True(t, metrics.BelongsTo(workload.(*WorkloadCollection)))
+	assert.Equal(t, "ingress", metrics.GetDependencies()[0].Name)
+	assert.Equal(t, workload, metrics.GetDependencies()[0].GetCollection())
+
+	// defaults of the nearest collection take precedence
+	assert.Equal(t, "v1alpha1", nested.GetAPIVersion())
+	assert.Equal(t, "observability", metrics.GetAPIGroup())
+	assert.Equal(t, "v1alpha1", metrics.GetAPIVersion())
+	assert.Equal(t, "platform", metrics.GetDependencies()[0].GetAPIGroup())
+}
+
+func Test_ProcessAPIConfigNestedCollectionFields(t *testing.T) {
+	t.Parallel()
+
+	dir := writeTestConfigs(t, map[string]string{
+		"platform.yaml": `
+name: platform
+kind: WorkloadCollection
+spec:
+  api:
+    domain: acme.com
+    group: platform
+    version: v1alpha1
+    kind: Platform
+  resources:
+    - platform-ns.yaml
+  componentFiles:
+    - observability.yaml
+`,
+		"platform-ns.yaml": `
+apiVersion: v1
+kind: Namespace
+metadata:
+  name: platform-system  # +operator-builder:collection:field:name=namespace,type=string
+`,
+		"observability.yaml": `
+name: observability
+kind: WorkloadCollection
+spec:
+  api:
+    group: observability
+    version: v1alpha1
+    kind: Observability
+  resources:
+    - observability-cm.yaml
+  componentFiles:
+    - metrics.yaml
+`,
+		"observability-cm.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: observability
+  namespace: platform-system  # +operator-builder:collection:field:name=namespace,type=string
+data:
+  tier: gold  # +operator-builder:field:name=tier,type=string
+`,
+		"metrics.yaml": `
+name: metrics
+kind: ComponentWorkload
+spec:
+  api:
+    group: observability
+    version: v1alpha1
+    kind: Metrics
+  resources:
+    - metrics-cm.yaml
+`,
+		"metrics-cm.yaml": `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: metrics
+  namespace: platform-system  # +operator-builder:collection:field:name=namespace,type=string
+data:
+  tier: gold  # +operator-builder:collection:field:name=tier,type=string
+  retention: 7d  # +operator-builder:collection:field:name=retention,type=string
+`,
+	})
+
+	workload, err := ProcessAPIConfig(filepath.Join(dir, "platform.yaml"))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	fieldNames := func(fields []*APISpecField) []string {
+		names := []string{}
+
+		for _, field := range fields {
+			names = append(names, field.ManifestFieldName)
+		}
+
+		return names
+	}
+
+	nested := workload.GetCollections()[0]
+	metrics := nested.GetComponents()[0]
+
+	// the namespace is defined by the root collection, the tier by the nested
+	// collection and the retention by no collection, so it is added to the
+	// collection in which the component is nested
+	assert.ElementsMatch(t, []string{"namespace"}, fieldNames(workload.GetAPISpecFields()))
+	assert.ElementsMatch(t, []string{"tier", "retention"}, fieldNames(nested.GetAPISpecFields()))
+	assert.Equal(t, []*WorkloadCollection{workload.(*WorkloadCollection)}, nested.GetAncestors())
+	assert.Equal(t, "platform", workload.(*WorkloadCollection).GetVarName())
+
+	sourceCode := metrics.GetSourceFiles()
+	if !assert.Len(t, *sourceCode, 1) {
+		return
+	}
+
+	code := (*sourceCode)[0].Children[0].SourceCode
+	assert.Contains(t, code, "platform.Spec.Namespace")
+	assert.Contains(t, code, "collection.Spec.Tier")
+	assert.Contains(t, code, "collection.Spec.Retention")
+
+	code = (*nested.GetSourceFiles())[0].Children[0].SourceCode
+	assert.Contains(t, code, "collection.Spec.Namespace")
+	assert.Contains(t, code, "parent.Spec.Tier")
+}
@@ -16,13 +16,17 @@ type WorkloadIdentifier interface {
 type WorkloadInitializer interface {
 	Validate() error
 
+	IsNamespaceScoped() bool
+	IsTracingEnabled() bool
 	HasRootCmdName() bool
 
 	GetDomain() string
 	GetRootCmdName() string
 	GetRootCmdDescr() string
+	GetComponents() []*ComponentWorkload
 
 	SetNames()
+	SetComponents(components []*ComponentWorkload) error
 }
 
 // WorkloadAPIBuilder defines the interface that must be implemented by a
@@ -31,6 +35,8 @@ type WorkloadAPIBuilder interface {
 	Validate() error
 
 	IsClusterScoped() bool
+	IsNamespaceScoped() bool
+	IsTracingEnabled() bool
 	IsStandalone() bool
 	IsComponent() bool
 	IsCollection() bool
@@ -52,12 +58,20 @@ type WorkloadAPIBuilder interface {
 	GetRootcommandVarName() string
 	GetDependencies() []*ComponentWorkload
 	GetComponents() []*ComponentWorkload
+	GetCollection() *WorkloadCollection
+	GetCollections() []*WorkloadCollection
+	GetManagedComponents() []*ManagedComponent
+	GetChildren() []WorkloadAPIBuilder
 	GetSourceFiles() *[]SourceFile
 	GetAPISpecFields() []*APISpecField
 	GetRBACRules() *[]RBACRule
 	GetOwnershipRules() *[]OwnershipRule
+	GetPersistence() *PersistenceConfig
 	GetComponentResource(domain, repo string, clusterScoped bool) *resource.Resource
 	GetFuncNames() (createFuncNames, initFuncNames []string)
+	GetReadyFuncNames() []string
+	GetResourceWaves() []int
+	GetResourceRecreatePolicies() []string
 	GetSubcommands() *[]CliCommand
 
 	SetNames()
@@ -0,0 +1,49 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+)
+
+var ErrUnsetVariable = errors.New("environment variable referenced in workload config is not set")
+
+// envVariablePattern matches references to environment variables in the form
+// of ${VAR} or ${VAR:-default}.
+var envVariablePattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+
+// interpolateEnv replaces references to environment variables in the content
+// of a workload config with their values.  A reference in the form of
+// ${VAR:-default} is replaced with the default value when the variable is not
+// set.  An error listing each variable that is not set is returned for any
+// reference without a default value.
+func interpolateEnv(content []byte) ([]byte, error) {
+	var missing []string
+
+	interpolated := envVariablePattern.ReplaceAllFunc(content, func(reference []byte) []byte {
+		matches := envVariablePattern.FindSubmatch(reference)
+
+		if value, found := os.LookupEnv(string(matches[1])); found {
+			return []byte(value)
+		}
+
+		// a default value has been provided if the separator is present
+		if len(matches[2]) > 0 {
+			return matches[3]
+		}
+
+		missing = append(missing, string(matches[1]))
+
+		return reference
+	})
+
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUnsetVariable, missing)
+	}
+
+	return interpolated, nil
+}
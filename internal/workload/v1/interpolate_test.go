@@ -0,0 +1,83 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//nolint:paralleltest // environment variables are shared across tests
+func Test_interpolateEnv(t *testing.T) {
+	for name, value := range map[string]string{
+		"OB_TEST_DOMAIN": "dev.acme.com",
+		"OB_TEST_EMPTY":  "",
+	} {
+		name := name
+		if err := os.Setenv(name, value); err != nil {
+			t.Fatal(err)
+		}
+
+		t.Cleanup(func() { os.Unsetenv(name) })
+	}
+
+	for _, tt := range []struct {
+		name     string
+		input    string
+		expected string
+		err      error
+	}{
+		{
+			name:     "content without variables",
+			input:    "domain: acme.com",
+			expected: "domain: acme.com",
+		},
+		{
+			name:     "variable that is set",
+			input:    "domain: ${OB_TEST_DOMAIN}",
+			expected: "domain: dev.acme.com",
+		},
+		{
+			name:     "variable that is set takes precedence over default",
+			input:    "domain: ${OB_TEST_DOMAIN:-acme.com}",
+			expected: "domain: dev.acme.com",
+		},
+		{
+			name:     "variable that is set to an empty value",
+			input:    "domain: '${OB_TEST_EMPTY:-acme.com}'",
+			expected: "domain: ''",
+		},
+		{
+			name:     "variable that is not set with a default",
+			input:    "domain: ${OB_TEST_MISSING:-acme.com}",
+			expected: "domain: acme.com",
+		},
+		{
+			name:     "variable that is not set with an empty default",
+			input:    "domain: '${OB_TEST_MISSING:-}'",
+			expected: "domain: ''",
+		},
+		{
+			name:  "variable that is not set without a default",
+			input: "domain: ${OB_TEST_MISSING}",
+			err:   ErrUnsetVariable,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			actual, err := interpolateEnv([]byte(tt.input))
+			if tt.err != nil {
+				assert.ErrorIs(t, err, tt.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, string(actual))
+		})
+	}
+}
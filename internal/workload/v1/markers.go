@@ -44,6 +44,7 @@ func processMarkers(
 	resources []string,
 	collection bool,
 	collectionResources bool,
+	parent *WorkloadCollection,
 ) (*SourceCodeTemplateData, error) {
 	const dataTypeString = "string"
 
@@ -72,6 +73,13 @@ func processMarkers(
 			return nil, formatProcessError(manifestFile, err)
 		}
 
+		// collection markers resolve against the nearest ancestor of the workload
+		// which defines their field, which is not always the parent collection
+		owners, err := resolveCollectionMarkers(markerResults, parent)
+		if err != nil {
+			return nil, formatProcessError(manifestFile, err)
+		}
+
 		buf := bytes.Buffer{}
 
 		for _, node := range nodes {
@@ -86,8 +94,19 @@ func processMarkers(
 
 		manifestContent = buf.Bytes()
 
+		// resource markers apply to the manifest of the document which contains them
+		resourceMarkers := make(map[int]ResourceMarker)
+
 		for _, markerResult := range markerResults {
 			switch r := markerResult.Object.(type) {
+			case ResourceMarker:
+				for i, node := range nodes {
+					if yamlNodeContains(node, markerResult.Nodes[0]) {
+						resourceMarkers[i] = r
+
+						break
+					}
+				}
 			case FieldMarker:
 				if collection && !collectionResources {
 					continue
@@ -135,7 +154,7 @@ func processMarkers(
 
 				specFields[r.Name] = specField
 			case CollectionFieldMarker:
-				if !collection {
+				if !collection || owners[r.Name] != parent {
 					continue
 				}
 
@@ -200,7 +219,7 @@ func processMarkers(
 
 		manifests := extractManifests(manifestContent)
 
-		for _, manifest := range manifests {
+		for i, manifest := range manifests {
 			// If processing manifests for collection resources there is no case
 			// where there should be collection markers - they will result in
 			// code that won't compile.  We will convert collection markers to
@@ -257,6 +276,33 @@ func processMarkers(
 				Kind:       manifestObject.GetKind(),
 			}
 
+			resource.Wave = defaultWave(resource.Kind)
+
+			if resourceMarker, ok := resourceMarkers[i]; ok {
+				if resourceMarker.Ready != nil {
+					readyChecks, err := parseReadyExpression(*resourceMarker.Ready)
+					if err != nil {
+						return nil, formatProcessError(manifestFile, err)
+					}
+
+					resource.ReadyExpression = *resourceMarker.Ready
+					resource.ReadyChecks = readyChecks
+				}
+
+				if resourceMarker.Wave != nil {
+					resource.Wave = *resourceMarker.Wave
+				}
+
+				if resourceMarker.Recreate != nil {
+					recreatePolicy, err := parseRecreatePolicy(*resourceMarker.Recreate)
+					if err != nil {
+						return nil, formatProcessError(manifestFile, err)
+					}
+
+					resource.RecreatePolicy = recreatePolicy
+				}
+			}
+
 			// generate the object source code
 			resourceDefinition, err := generate.Generate([]byte(manifest), "resourceObj")
 			if err != nil {
@@ -284,6 +330,80 @@ func processMarkers(
 	return results, nil
 }
 
+// resolveCollectionMarkers resolves the collection markers against the nearest
+// ancestor of a workload which is nested in a parent collection that defines
+// their field, and returns the ancestor of each field.  The collection markers
+// which resolve against a collection above the parent collection refer to the
+// variable of that collection rather than to the parent collection.
+func resolveCollectionMarkers(
+	markerResults []*inspect.YAMLResult,
+	parent *WorkloadCollection,
+) (map[string]*WorkloadCollection, error) {
+	owners := make(map[string]*WorkloadCollection)
+
+	for _, markerResult := range markerResults {
+		r, ok := markerResult.Object.(CollectionFieldMarker)
+		if !ok {
+			continue
+		}
+
+		owner, err := resolveCollectionField(parent, r.Name)
+		if err != nil {
+			return nil, err
+		}
+
+		owners[r.Name] = owner
+
+		if owner == parent {
+			continue
+		}
+
+		value := markerResult.Nodes[0]
+		if len(markerResult.Nodes) > 1 {
+			value = markerResult.Nodes[1]
+		}
+
+		value.Value = fmt.Sprintf("%s.Spec.%s", owner.GetVarName(), strings.Title(r.Name))
+	}
+
+	return owners, nil
+}
+
+// markerFieldNames returns the names of the fields of the field markers and of
+// the collection markers in the resource manifests of a workload.
+func markerFieldNames(workloadPath string, resources []string) (map[string]bool, map[string]bool, error) {
+	fields := make(map[string]bool)
+	collectionFields := make(map[string]bool)
+
+	for _, manifestFile := range resources {
+		manifestContent, err := ioutil.ReadFile(filepath.Join(filepath.Dir(workloadPath), manifestFile))
+		if err != nil {
+			return nil, nil, formatProcessError(manifestFile, err)
+		}
+
+		insp, err := InitializeMarkerInspector()
+		if err != nil {
+			return nil, nil, formatProcessError(manifestFile, err)
+		}
+
+		_, markerResults, err := insp.InspectYAML(manifestContent, TransformYAML)
+		if err != nil {
+			return nil, nil, formatProcessError(manifestFile, err)
+		}
+
+		for _, markerResult := range markerResults {
+			switch r := markerResult.Object.(type) {
+			case FieldMarker:
+				fields[r.Name] = true
+			case CollectionFieldMarker:
+				collectionFields[r.Name] = true
+			}
+		}
+	}
+
+	return fields, collectionFields, nil
+}
+
 // deduplicateFileNames dedeplicates the names of the files.  This is because
 // we cannot guarantee that files exist in different directories and may have
 // naming collisions.
@@ -346,8 +466,14 @@ func InitializeMarkerInspector() (*inspect.Inspector, error) {
 		return nil, fmt.Errorf("%w", err)
 	}
 
+	resourceMarker, err := marker.Define("+operator-builder:resource", ResourceMarker{})
+	if err != nil {
+		return nil, fmt.Errorf("%w", err)
+	}
+
 	registry.Add(fieldMarker)
 	registry.Add(collectionMarker)
+	registry.Add(resourceMarker)
 
 	return inspect.NewInspector(registry), nil
 }
@@ -455,6 +581,14 @@ type FieldMarker struct {
 
 type CollectionFieldMarker FieldMarker
 
+// ResourceMarker defines the attributes of a child resource which are set by a marker
+// in the head comment of the resource manifest.
+type ResourceMarker struct {
+	Ready    *string
+	Wave     *int
+	Recreate *string
+}
+
 func (fm FieldMarker) String() string {
 	return fmt.Sprintf("FieldMarker{Name: %s Type: %v Description: %q Default: %v}",
 		fm.Name,
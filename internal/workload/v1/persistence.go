@@ -0,0 +1,67 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+)
+
+var ErrInvalidRecreatePolicy = errors.New("invalid recreate policy")
+
+// GetStrategy returns the persistence strategy for child resources of a kind.
+func (config *PersistenceConfig) GetStrategy(kind string) PersistenceStrategy {
+	if strategy, ok := config.Kinds[kind]; ok && strategy != "" {
+		return strategy
+	}
+
+	if config.Strategy == "" {
+		return PersistenceStrategyPatch
+	}
+
+	return config.Strategy
+}
+
+// ForceConflicts determines if conflicts with other field managers are forced
+// when child resources are persisted with server-side apply.
+func (config *PersistenceConfig) ForceConflicts() bool {
+	return config.Conflicts != ApplyConflictsReport
+}
+
+// GetRecreatePolicy returns the recreate policy for child resources of a kind.
+func (config *PersistenceConfig) GetRecreatePolicy(kind string) RecreatePolicy {
+	if policy, ok := config.Recreate.Kinds[kind]; ok && policy != "" {
+		return policy
+	}
+
+	if config.Recreate.Policy == "" {
+		return RecreatePolicyNever
+	}
+
+	return config.Recreate.Policy
+}
+
+// parseRecreatePolicy parses the recreate policy of a resource marker.
+func parseRecreatePolicy(policy string) (RecreatePolicy, error) {
+	switch recreatePolicy := RecreatePolicy(policy); recreatePolicy {
+	case RecreatePolicyNever, RecreatePolicyRecreate, RecreatePolicyOrphan:
+		return recreatePolicy, nil
+	}
+
+	return "", fmt.Errorf("%w %q; expected one of %s, %s or %s", ErrInvalidRecreatePolicy, policy,
+		RecreatePolicyNever, RecreatePolicyRecreate, RecreatePolicyOrphan)
+}
+
+// getResourceRecreatePolicies returns the recreate policies of the resource markers of the child
+// resources, in the same order as the create functions.  The policy of a child resource without a
+// recreate policy in its resource marker is empty.
+func getResourceRecreatePolicies(sourceFiles []SourceFile) (policies []string) {
+	for _, sourceFile := range sourceFiles {
+		for _, childResource := range sourceFile.Children {
+			policies = append(policies, string(childResource.RecreatePolicy))
+		}
+	}
+
+	return policies
+}
@@ -0,0 +1,136 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PersistenceConfigGetStrategy(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name     string
+		config   PersistenceConfig
+		kind     string
+		expected PersistenceStrategy
+	}{
+		{
+			name:     "empty config defaults to patch",
+			config:   PersistenceConfig{},
+			kind:     "Deployment",
+			expected: PersistenceStrategyPatch,
+		},
+		{
+			name:     "project strategy",
+			config:   PersistenceConfig{Strategy: PersistenceStrategyApply},
+			kind:     "Deployment",
+			expected: PersistenceStrategyApply,
+		},
+		{
+			name: "kind strategy overrides project strategy",
+			config: PersistenceConfig{
+				Strategy: PersistenceStrategyApply,
+				Kinds:    map[string]PersistenceStrategy{"ConfigMap": PersistenceStrategyPatch},
+			},
+			kind:     "ConfigMap",
+			expected: PersistenceStrategyPatch,
+		},
+		{
+			name: "kind strategy does not apply to other kinds",
+			config: PersistenceConfig{
+				Kinds: map[string]PersistenceStrategy{"ConfigMap": PersistenceStrategyApply},
+			},
+			kind:     "Deployment",
+			expected: PersistenceStrategyPatch,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, tt.config.GetStrategy(tt.kind))
+		})
+	}
+}
+
+func Test_PersistenceConfigForceConflicts(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, (&PersistenceConfig{}).ForceConflicts())
+	assert.True(t, (&PersistenceConfig{Conflicts: ApplyConflictsForce}).ForceConflicts())
+	assert.False(t, (&PersistenceConfig{Conflicts: ApplyConflictsReport}).ForceConflicts())
+}
+
+func Test_PersistenceConfigGetRecreatePolicy(t *testing.T) {
+	t.Parallel()
+
+	config := PersistenceConfig{
+		Recreate: RecreateConfig{
+			Policy: RecreatePolicyRecreate,
+			Kinds:  map[string]RecreatePolicy{"StatefulSet": RecreatePolicyOrphan},
+		},
+	}
+
+	assert.Equal(t, RecreatePolicyNever, (&PersistenceConfig{}).GetRecreatePolicy("Job"))
+	assert.Equal(t, RecreatePolicyRecreate, config.GetRecreatePolicy("Job"))
+	assert.Equal(t, RecreatePolicyOrphan, config.GetRecreatePolicy("StatefulSet"))
+}
+
+func Test_processMarkersResourceRecreate(t *testing.T) {
+	t.Parallel()
+
+	manifests := `---
+# +operator-builder:resource:recreate="orphan"
+apiVersion: apps/v1
+kind: StatefulSet
+metadata:
+  name: database
+---
+apiVersion: batch/v1
+kind: Job
+metadata:
+  name: migrate
+`
+
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.yaml"), []byte(manifests), 0o600))
+
+	results, err := processMarkers(filepath.Join(dir, "workload.yaml"), []string{"app.yaml"}, false, false, nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orphan", ""}, getResourceRecreatePolicies(*results.SourceFiles))
+
+	invalid := "# +operator-builder:resource:recreate=\"always\"\n" + manifests[strings.Index(manifests, "apiVersion: batch"):]
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte(invalid), 0o600))
+
+	_, err = processMarkers(filepath.Join(dir, "workload.yaml"), []string{"invalid.yaml"}, false, false, nil)
+	assert.ErrorIs(t, err, ErrInvalidRecreatePolicy)
+}
+
+func Test_WorkloadCollectionGetPersistence(t *testing.T) {
+	t.Parallel()
+
+	root := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{Persistence: PersistenceConfig{Strategy: PersistenceStrategyApply}},
+	}
+
+	nested := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{
+			Persistence: PersistenceConfig{Strategy: PersistenceStrategyPatch},
+			Collection:  root,
+		},
+	}
+
+	component := &ComponentWorkload{Spec: ComponentWorkloadSpec{Collection: nested}}
+
+	assert.Equal(t, PersistenceStrategyApply, nested.GetPersistence().Strategy)
+	assert.Equal(t, PersistenceStrategyApply, component.GetPersistence().Strategy)
+	assert.Equal(t, PersistenceStrategy(""), (&ComponentWorkload{}).GetPersistence().Strategy)
+}
@@ -157,3 +157,78 @@ func rbacRulesForManifest(kind, group string, rawContent interface{}, rbacRules
 		}
 	}
 }
+
+// addTo adds the explicitly configured rbac rules to the rbac rules and then
+// limits the verbs of the rbac rules to those allowed by the restrictions.
+func (config *RBACConfig) addTo(rbacRules *[]RBACRule) {
+	for _, rule := range config.Rules {
+		for _, resource := range rule.Resources {
+			rbacRulesAddOrUpdate(
+				rbacRules,
+				&RBACRule{
+					Group:    rbacGroupFromGroup(rule.Group),
+					Resource: resource,
+					Verbs:    rule.Verbs,
+				},
+			)
+		}
+	}
+
+	for _, restriction := range config.Restrictions {
+		for _, resource := range restriction.Resources {
+			rbacRulesRestrict(
+				rbacRules,
+				&RBACRule{
+					Group:    rbacGroupFromGroup(restriction.Group),
+					Resource: resource,
+					Verbs:    restriction.Verbs,
+				},
+			)
+		}
+	}
+}
+
+// rbacRulesRestrict limits the verbs of the rbac rule matching the group and
+// resource of the restriction to the verbs of the restriction.  The rule is
+// removed if none of its verbs are allowed.
+func rbacRulesRestrict(rbacRules *[]RBACRule, restriction *RBACRule) {
+	rules := []RBACRule{}
+
+	for _, rule := range *rbacRules {
+		rule := rule
+		if !groupResourceEqual(&rule, restriction) {
+			rules = append(rules, rule)
+
+			continue
+		}
+
+		var verbs []string
+
+		for _, verb := range rule.Verbs {
+			// a wildcard verb is replaced by the verbs of the restriction
+			if verb == "*" {
+				verbs = restriction.Verbs
+
+				break
+			}
+
+			for _, allowedVerb := range restriction.Verbs {
+				if verb == allowedVerb {
+					verbs = append(verbs, verb)
+
+					break
+				}
+			}
+		}
+
+		if len(verbs) == 0 {
+			continue
+		}
+
+		rule.Verbs = verbs
+		rule.VerbString = rbacVerbsToString(verbs)
+		rules = append(rules, rule)
+	}
+
+	*rbacRules = rules
+}
@@ -0,0 +1,199 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v3"
+)
+
+const rbacWildcard = "*"
+
+var ErrEscalatingRBACRules = errors.New("rbac rules grant wildcard or privilege escalating permissions")
+
+// escalatingVerbs returns the verbs which allow a subject to gain permissions
+// beyond those which are granted to it.
+func escalatingVerbs() []string {
+	return []string{
+		"escalate", "bind", "impersonate",
+	}
+}
+
+// RBACReport contains the effective rbac rules which are granted to the operator
+// along with the warnings for rules which grant wildcard or privilege escalating
+// permissions.
+type RBACReport struct {
+	Rules    []RBACReportRule `json:"rules" yaml:"rules"`
+	Warnings []string         `json:"warnings,omitempty" yaml:"warnings,omitempty"`
+}
+
+// RBACReportRule is a single rule of the effective rbac rules in an RBACReport.
+type RBACReportRule struct {
+	Group    string   `json:"group" yaml:"group"`
+	Resource string   `json:"resource" yaml:"resource"`
+	Verbs    []string `json:"verbs" yaml:"verbs"`
+}
+
+// NewRBACReport analyzes the rbac rules of a workload, including the rules
+// of its nested collections and components, and returns the resulting report.
+func NewRBACReport(workload WorkloadAPIBuilder) *RBACReport {
+	rules := []RBACRule{}
+
+	addRules := func(workloadRules *[]RBACRule) {
+		for _, rule := range *workloadRules {
+			rule := rule
+			rule.Verbs = append([]string{}, rule.Verbs...)
+			rbacRulesAddOrUpdate(&rules, &rule)
+		}
+	}
+
+	addRules(workload.GetRBACRules())
+
+	for _, collection := range workload.GetCollections() {
+		addRules(collection.GetRBACRules())
+	}
+
+	for _, component := range workload.GetComponents() {
+		addRules(component.GetRBACRules())
+	}
+
+	rbacRulesCollapse(&rules)
+
+	report := &RBACReport{Rules: make([]RBACReportRule, len(rules))}
+
+	for i, rule := range rules {
+		rule := rule
+
+		report.Rules[i] = RBACReportRule{
+			Group:    rule.Group,
+			Resource: rule.Resource,
+			Verbs:    rule.Verbs,
+		}
+
+		report.Warnings = append(report.Warnings, rbacRuleWarnings(&rule)...)
+	}
+
+	return report
+}
+
+// Validate returns an error if the report contains warnings.
+func (report *RBACReport) Validate() error {
+	if len(report.Warnings) > 0 {
+		return fmt.Errorf("%w: %v", ErrEscalatingRBACRules, report.Warnings)
+	}
+
+	return nil
+}
+
+// Write writes the report as yaml to the file at path.
+func (report *RBACReport) Write(path string) error {
+	content, err := yaml.Marshal(report)
+	if err != nil {
+		return fmt.Errorf("unable to marshal rbac report, %w", err)
+	}
+
+	if err := ioutil.WriteFile(path, content, 0o600); err != nil {
+		return fmt.Errorf("unable to write rbac report to %s, %w", path, err)
+	}
+
+	return nil
+}
+
+func rbacRuleWarnings(rule *RBACRule) []string {
+	var warnings []string
+
+	name := fmt.Sprintf("rule for group %q and resource %q", rule.Group, rule.Resource)
+
+	if rule.Group == rbacWildcard {
+		warnings = append(warnings, fmt.Sprintf("%s grants access to all api groups", name))
+	}
+
+	if rule.Resource == rbacWildcard {
+		warnings = append(warnings, fmt.Sprintf("%s grants access to all resources", name))
+	}
+
+	if rbacVerbsContain(rule.Verbs, rbacWildcard) {
+		warnings = append(warnings, fmt.Sprintf("%s grants all verbs", name))
+
+		return warnings
+	}
+
+	for _, verb := range escalatingVerbs() {
+		if rbacVerbsContain(rule.Verbs, verb) {
+			warnings = append(warnings, fmt.Sprintf("%s grants the %s verb which allows privilege escalation", name, verb))
+		}
+	}
+
+	return warnings
+}
+
+func rbacVerbsContain(verbs []string, verb string) bool {
+	for _, existingVerb := range verbs {
+		if existingVerb == verb {
+			return true
+		}
+	}
+
+	return false
+}
+
+// rbacRuleCovers determines if all permissions of a rule are also granted by
+// the covering rule.
+func rbacRuleCovers(coveringRule, rule *RBACRule) bool {
+	if coveringRule.Group != rbacWildcard && coveringRule.Group != rule.Group {
+		return false
+	}
+
+	if coveringRule.Resource != rbacWildcard && coveringRule.Resource != rule.Resource {
+		return false
+	}
+
+	if rbacVerbsContain(coveringRule.Verbs, rbacWildcard) {
+		return true
+	}
+
+	for _, verb := range rule.Verbs {
+		if !rbacVerbsContain(coveringRule.Verbs, verb) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// rbacRulesCollapse reduces the verbs of rules which contain a wildcard verb to
+// the wildcard and removes the rules which are covered by another rule.
+func rbacRulesCollapse(rbacRules *[]RBACRule) {
+	rules := *rbacRules
+
+	for i := range rules {
+		if rbacVerbsContain(rules[i].Verbs, rbacWildcard) {
+			rules[i].Verbs = []string{rbacWildcard}
+			rules[i].VerbString = rbacWildcard
+		}
+	}
+
+	collapsed := []RBACRule{}
+
+	for i := range rules {
+		var covered bool
+
+		for j := range rules {
+			if i != j && rbacRuleCovers(&rules[j], &rules[i]) {
+				covered = true
+
+				break
+			}
+		}
+
+		if !covered {
+			collapsed = append(collapsed, rules[i])
+		}
+	}
+
+	*rbacRules = collapsed
+}
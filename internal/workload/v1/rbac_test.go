@@ -0,0 +1,133 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_RBACConfigAddTo(t *testing.T) {
+	t.Parallel()
+
+	derivedRules := func() []RBACRule {
+		return []RBACRule{
+			{Group: "apps", Resource: "deployments", Verbs: defaultResourceVerbs(), VerbString: rbacVerbsToString(defaultResourceVerbs())},
+			{Group: "core", Resource: "secrets", Verbs: defaultResourceVerbs(), VerbString: rbacVerbsToString(defaultResourceVerbs())},
+			{Group: "core", Resource: "pods", Verbs: []string{"*"}, VerbString: "*"},
+		}
+	}
+
+	for _, tt := range []struct {
+		name     string
+		config   RBACConfig
+		expected []RBACRule
+	}{
+		{
+			name:     "empty config",
+			config:   RBACConfig{},
+			expected: derivedRules(),
+		},
+		{
+			name: "rules are added and merged",
+			config: RBACConfig{
+				Rules: []RBACRuleConfig{
+					{Group: "", Resources: []string{"nodes"}, Verbs: []string{"get", "list"}},
+					{Group: "apps", Resources: []string{"deployments"}, Verbs: []string{"deletecollection"}},
+				},
+			},
+			expected: append(
+				[]RBACRule{
+					{
+						Group:      "apps",
+						Resource:   "deployments",
+						Verbs:      append(defaultResourceVerbs(), "deletecollection"),
+						VerbString: "get;list;watch;create;update;patch;delete;deletecollection",
+					},
+				},
+				derivedRules()[1],
+				derivedRules()[2],
+				RBACRule{Group: "core", Resource: "nodes", Verbs: []string{"get", "list"}, VerbString: "get;list"},
+			),
+		},
+		{
+			name: "restrictions limit verbs and remove rules without verbs",
+			config: RBACConfig{
+				Restrictions: []RBACRuleConfig{
+					{Group: "core", Resources: []string{"secrets"}, Verbs: []string{"get", "list", "watch"}},
+					{Group: "core", Resources: []string{"pods"}, Verbs: []string{"get"}},
+					{Group: "apps", Resources: []string{"deployments"}, Verbs: []string{"escalate"}},
+				},
+			},
+			expected: []RBACRule{
+				{Group: "core", Resource: "secrets", Verbs: []string{"get", "list", "watch"}, VerbString: "get;list;watch"},
+				{Group: "core", Resource: "pods", Verbs: []string{"get"}, VerbString: "get"},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := derivedRules()
+			tt.config.addTo(&rules)
+			assert.Equal(t, tt.expected, rules)
+		})
+	}
+}
+
+func Test_rbacRulesCollapse(t *testing.T) {
+	t.Parallel()
+
+	rules := []RBACRule{
+		{Group: "apps", Resource: "deployments", Verbs: []string{"get", "list"}, VerbString: "get;list"},
+		{Group: "apps", Resource: "*", Verbs: []string{"get", "list", "watch"}, VerbString: "get;list;watch"},
+		{Group: "core", Resource: "pods", Verbs: []string{"get", "*"}, VerbString: "get;*"},
+		{Group: "core", Resource: "secrets", Verbs: []string{"get"}, VerbString: "get"},
+		{Group: "batch", Resource: "jobs", Verbs: []string{"create"}, VerbString: "create"},
+	}
+
+	rbacRulesCollapse(&rules)
+
+	assert.Equal(t, []RBACRule{
+		{Group: "apps", Resource: "*", Verbs: []string{"get", "list", "watch"}, VerbString: "get;list;watch"},
+		{Group: "core", Resource: "pods", Verbs: []string{"*"}, VerbString: "*"},
+		{Group: "core", Resource: "secrets", Verbs: []string{"get"}, VerbString: "get"},
+		{Group: "batch", Resource: "jobs", Verbs: []string{"create"}, VerbString: "create"},
+	}, rules)
+}
+
+func Test_NewRBACReport(t *testing.T) {
+	t.Parallel()
+
+	workload := &StandaloneWorkload{
+		Spec: StandaloneWorkloadSpec{
+			RBACRules: []RBACRule{
+				{Group: "core", Resource: "configmaps", Verbs: defaultResourceVerbs()},
+				{Group: "*", Resource: "*", Verbs: []string{"get"}},
+				{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Verbs: []string{"bind", "escalate"}},
+				{Group: "core", Resource: "pods", Verbs: []string{"*"}},
+			},
+		},
+	}
+
+	report := NewRBACReport(workload)
+
+	assert.Equal(t, []RBACReportRule{
+		{Group: "core", Resource: "configmaps", Verbs: defaultResourceVerbs()},
+		{Group: "*", Resource: "*", Verbs: []string{"get"}},
+		{Group: "rbac.authorization.k8s.io", Resource: "clusterroles", Verbs: []string{"bind", "escalate"}},
+		{Group: "core", Resource: "pods", Verbs: []string{"*"}},
+	}, report.Rules)
+	assert.Equal(t, []string{
+		`rule for group "*" and resource "*" grants access to all api groups`,
+		`rule for group "*" and resource "*" grants access to all resources`,
+		`rule for group "rbac.authorization.k8s.io" and resource "clusterroles" grants the escalate verb which allows privilege escalation`,
+		`rule for group "rbac.authorization.k8s.io" and resource "clusterroles" grants the bind verb which allows privilege escalation`,
+		`rule for group "core" and resource "pods" grants all verbs`,
+	}, report.Warnings)
+	assert.ErrorIs(t, report.Validate(), ErrEscalatingRBACRules)
+}
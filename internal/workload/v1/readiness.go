@@ -0,0 +1,192 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+var ErrInvalidReadyExpression = errors.New("invalid ready expression")
+
+// readyOperators returns the supported comparison operators of a ready expression.  Operators
+// which are prefixes of other operators are listed last so that they are matched last.
+func readyOperators() []string {
+	return []string{"==", "!=", ">=", "<=", ">", "<"}
+}
+
+// ReadyCheck is a single comparison of a ready expression, which compares the value of a
+// field of a child resource to a literal value.
+type ReadyCheck struct {
+	// Path is the path to the field, e.g. ["status", "phase"].
+	Path []string
+
+	// Operator is the comparison operator, e.g. "==".
+	Operator string
+
+	// Value is the literal value as Go source code, e.g. "Running" including the quotes.
+	Value string
+}
+
+// parseReadyExpression parses a ready expression into the checks which must all be true for
+// a child resource to be ready.  An expression consists of one or more comparisons which are
+// joined by '&&', e.g. ".status.phase == 'Running' && .status.replicas >= 1".  A '&&' within a
+// quoted string is part of the string rather than a separator.
+func parseReadyExpression(expression string) ([]ReadyCheck, error) {
+	checks := []ReadyCheck{}
+
+	for _, comparison := range splitOutsideQuotes(expression, "&&") {
+		check, err := parseReadyComparison(strings.TrimSpace(comparison))
+		if err != nil {
+			return nil, fmt.Errorf("%w %q; %s", ErrInvalidReadyExpression, expression, err.Error())
+		}
+
+		checks = append(checks, *check)
+	}
+
+	return checks, nil
+}
+
+func parseReadyComparison(comparison string) (*ReadyCheck, error) {
+	for _, operator := range readyOperators() {
+		index := indexOutsideQuotes(comparison, operator)
+		if index < 0 {
+			continue
+		}
+
+		path := strings.TrimSpace(comparison[:index])
+		if !strings.HasPrefix(path, ".") || len(path) == 1 {
+			return nil, fmt.Errorf("field path %q must begin with '.'", path)
+		}
+
+		check := &ReadyCheck{
+			Path:     strings.Split(strings.TrimPrefix(path, "."), "."),
+			Operator: operator,
+		}
+
+		for _, segment := range check.Path {
+			if segment == "" {
+				return nil, fmt.Errorf("field path %q contains an empty segment", path)
+			}
+		}
+
+		value, isNumber, err := parseReadyValue(strings.TrimSpace(comparison[index+len(operator):]))
+		if err != nil {
+			return nil, err
+		}
+
+		if !isNumber && operator != "==" && operator != "!=" {
+			return nil, fmt.Errorf("operator %s requires a numeric value", operator)
+		}
+
+		check.Value = value
+
+		return check, nil
+	}
+
+	return nil, fmt.Errorf("comparison %q requires one of the operators %v", comparison, readyOperators())
+}
+
+// splitOutsideQuotes splits a string at each separator which is not within a single or double
+// quoted string.
+func splitOutsideQuotes(value, separator string) []string {
+	parts := []string{}
+
+	for {
+		index := indexOutsideQuotes(value, separator)
+		if index < 0 {
+			return append(parts, value)
+		}
+
+		parts = append(parts, value[:index])
+		value = value[index+len(separator):]
+	}
+}
+
+// indexOutsideQuotes returns the index of the first instance of a substring which is not within
+// a single or double quoted string, or -1 if there is none.
+func indexOutsideQuotes(value, substring string) int {
+	var quote byte
+
+	for i := 0; i < len(value); i++ {
+		switch {
+		case quote != 0:
+			if value[i] == quote {
+				quote = 0
+			}
+		case value[i] == '\'' || value[i] == '"':
+			quote = value[i]
+		case strings.HasPrefix(value[i:], substring):
+			return i
+		}
+	}
+
+	return -1
+}
+
+// parseReadyValue returns the Go source code of the literal value of a comparison and whether
+// the value is a number.
+func parseReadyValue(value string) (source string, isNumber bool, err error) {
+	switch {
+	case len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0]:
+		return strconv.Quote(value[1 : len(value)-1]), false, nil
+	case value == "true" || value == "false":
+		return value, false, nil
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err != nil {
+		return "", false, fmt.Errorf("value %q must be a quoted string, a number or a boolean", value)
+	}
+
+	return fmt.Sprintf("float64(%s)", value), true, nil
+}
+
+// yamlNodeContains determines if a yaml node is the target node or contains the target node.
+func yamlNodeContains(node, target *yaml.Node) bool {
+	if node == target {
+		return true
+	}
+
+	for _, child := range node.Content {
+		if yamlNodeContains(child, target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// getReadyFuncNames returns the names of the functions which determine the readiness of the
+// child resources, in the same order as the create functions.  The name is empty for child
+// resources without a ready expression.
+func getReadyFuncNames(sourceFiles []SourceFile) (readyFuncNames []string) {
+	for _, sourceFile := range sourceFiles {
+		for _, childResource := range sourceFile.Children {
+			var funcName string
+
+			if len(childResource.ReadyChecks) > 0 {
+				funcName = fmt.Sprintf("%sIsReady", childResource.UniqueName)
+			}
+
+			readyFuncNames = append(readyFuncNames, funcName)
+		}
+	}
+
+	return readyFuncNames
+}
+
+// HasReadyChecks determines if any child resource of a source file has a ready expression.
+func (sourceFile SourceFile) HasReadyChecks() bool {
+	for _, childResource := range sourceFile.Children {
+		if len(childResource.ReadyChecks) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,142 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_parseReadyExpression(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		name       string
+		expression string
+		expected   []ReadyCheck
+		wantErr    bool
+	}{
+		{
+			name:       "single quoted string",
+			expression: ".status.phase == 'Running'",
+			expected: []ReadyCheck{
+				{Path: []string{"status", "phase"}, Operator: "==", Value: `"Running"`},
+			},
+		},
+		{
+			name:       "multiple comparisons",
+			expression: `.status.phase != "Failed" && .status.readyReplicas >= 2 && .status.ok == true`,
+			expected: []ReadyCheck{
+				{Path: []string{"status", "phase"}, Operator: "!=", Value: `"Failed"`},
+				{Path: []string{"status", "readyReplicas"}, Operator: ">=", Value: "float64(2)"},
+				{Path: []string{"status", "ok"}, Operator: "==", Value: "true"},
+			},
+		},
+		{
+			name:       "separator and operator within quoted strings",
+			expression: `.metadata.annotations.check == 'a && b' && .status.message != "x == y"`,
+			expected: []ReadyCheck{
+				{Path: []string{"metadata", "annotations", "check"}, Operator: "==", Value: `"a && b"`},
+				{Path: []string{"status", "message"}, Operator: "!=", Value: `"x == y"`},
+			},
+		},
+		{
+			name:       "unterminated quoted string",
+			expression: ".status.phase == 'Running && .status.ok == true",
+			wantErr:    true,
+		},
+		{
+			name:       "missing operator",
+			expression: ".status.phase",
+			wantErr:    true,
+		},
+		{
+			name:       "missing leading dot",
+			expression: "status.phase == 'Running'",
+			wantErr:    true,
+		},
+		{
+			name:       "empty path segment",
+			expression: ".status..phase == 'Running'",
+			wantErr:    true,
+		},
+		{
+			name:       "unquoted string",
+			expression: ".status.phase == Running",
+			wantErr:    true,
+		},
+		{
+			name:       "ordering of a string",
+			expression: ".status.phase > 'Running'",
+			wantErr:    true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			checks, err := parseReadyExpression(tt.expression)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrInvalidReadyExpression)
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, checks)
+		})
+	}
+}
+
+func Test_processMarkersResourceMarker(t *testing.T) {
+	t.Parallel()
+
+	manifests := `---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+---
+# +operator-builder:resource:ready=".status.phase == 'Running'"
+apiVersion: v1
+kind: Pod
+metadata:
+  name: pod
+spec:
+  containers:
+    - name: app
+      image: nginx
+`
+
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.yaml"), []byte(manifests), 0o600))
+
+	results, err := processMarkers(filepath.Join(dir, "workload.yaml"), []string{"app.yaml"}, false, false, nil)
+	require.NoError(t, err)
+
+	sourceFiles := *results.SourceFiles
+	require.Len(t, sourceFiles, 1)
+	require.Len(t, sourceFiles[0].Children, 2)
+	assert.True(t, sourceFiles[0].HasReadyChecks())
+	assert.Empty(t, sourceFiles[0].Children[0].ReadyChecks)
+	assert.Equal(t, ".status.phase == 'Running'", sourceFiles[0].Children[1].ReadyExpression)
+	assert.NotContains(t, sourceFiles[0].Children[1].StaticContent, "operator-builder")
+	assert.Equal(t, []string{"", "PodPodIsReady"}, getReadyFuncNames(sourceFiles))
+
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "invalid.yaml"), []byte(`---
+# +operator-builder:resource:ready=".status.phase"
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`), 0o600))
+
+	_, err = processMarkers(filepath.Join(dir, "workload.yaml"), []string{"invalid.yaml"}, false, false, nil)
+	assert.ErrorIs(t, err, ErrInvalidReadyExpression)
+}
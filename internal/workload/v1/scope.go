@@ -0,0 +1,83 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrClusterScopedResources = errors.New("cluster-scoped resources are not supported by a namespace-scoped operator")
+
+// clusterScopedKinds returns the kinds of the Kubernetes APIs whose resources are
+// cluster-scoped.  The scope of the kinds of custom resources which are not created by
+// the operator is not known at generation time.
+func clusterScopedKinds() map[string]bool {
+	return map[string]bool{
+		"APIService":                     true,
+		"CertificateSigningRequest":      true,
+		"ClusterRole":                    true,
+		"ClusterRoleBinding":             true,
+		"ComponentStatus":                true,
+		"CSIDriver":                      true,
+		"CSINode":                        true,
+		"CustomResourceDefinition":       true,
+		"FlowSchema":                     true,
+		"IngressClass":                   true,
+		"MutatingWebhookConfiguration":   true,
+		"Namespace":                      true,
+		"Node":                           true,
+		"PersistentVolume":               true,
+		"PodSecurityPolicy":              true,
+		"PriorityClass":                  true,
+		"PriorityLevelConfiguration":     true,
+		"RuntimeClass":                   true,
+		"StorageClass":                   true,
+		"ValidatingWebhookConfiguration": true,
+		"VolumeAttachment":               true,
+	}
+}
+
+// ValidateOperatorScope returns an error when the operator of a workload is namespace-scoped
+// but the custom resources of the workload, or of its nested collections and components, are
+// cluster-scoped or their manifests contain cluster-scoped resources.
+func ValidateOperatorScope(workload WorkloadAPIBuilder) error {
+	if !workload.IsNamespaceScoped() {
+		return nil
+	}
+
+	workloads := []WorkloadAPIBuilder{workload}
+
+	for _, collection := range workload.GetCollections() {
+		workloads = append(workloads, collection)
+	}
+
+	for _, component := range workload.GetComponents() {
+		workloads = append(workloads, component)
+	}
+
+	kinds := clusterScopedKinds()
+	found := []string{}
+
+	for _, w := range workloads {
+		if w.IsClusterScoped() {
+			found = append(found, fmt.Sprintf("custom resource %s of workload %s", w.GetAPIKind(), w.GetName()))
+		}
+
+		for _, sourceFile := range *w.GetSourceFiles() {
+			for _, child := range sourceFile.Children {
+				if kinds[child.Kind] {
+					found = append(found, fmt.Sprintf("%s %s of workload %s", child.Kind, child.Name, w.GetName()))
+				}
+			}
+		}
+	}
+
+	if len(found) > 0 {
+		return fmt.Errorf("%w; found %s", ErrClusterScopedResources, strings.Join(found, ", "))
+	}
+
+	return nil
+}
@@ -0,0 +1,103 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_WorkloadCollectionIsNamespaceScoped(t *testing.T) {
+	t.Parallel()
+
+	root := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{OperatorScope: OperatorScopeNamespace},
+	}
+
+	nested := &WorkloadCollection{
+		Spec: WorkloadCollectionSpec{
+			OperatorScope: OperatorScopeCluster,
+			Collection:    root,
+		},
+	}
+
+	component := &ComponentWorkload{Spec: ComponentWorkloadSpec{Collection: nested}}
+
+	assert.True(t, nested.IsNamespaceScoped())
+	assert.True(t, component.IsNamespaceScoped())
+	assert.False(t, (&ComponentWorkload{}).IsNamespaceScoped())
+	assert.False(t, (&StandaloneWorkload{}).IsNamespaceScoped())
+}
+
+func Test_ValidateOperatorScope(t *testing.T) {
+	t.Parallel()
+
+	sourceFiles := []SourceFile{
+		{
+			Filename: "resources.go",
+			Children: []ChildResource{
+				{Name: "webstore", Kind: "Deployment"},
+				{Name: "webstore-reader", Kind: "ClusterRole"},
+			},
+		},
+	}
+
+	for _, tt := range []struct {
+		name     string
+		workload *StandaloneWorkload
+		wantErr  bool
+	}{
+		{
+			name: "cluster-scoped operator with cluster-scoped resources",
+			workload: &StandaloneWorkload{
+				Spec: StandaloneWorkloadSpec{SourceFiles: sourceFiles},
+			},
+			wantErr: false,
+		},
+		{
+			name: "namespace-scoped operator with namespaced resources",
+			workload: &StandaloneWorkload{
+				Spec: StandaloneWorkloadSpec{
+					OperatorScope: OperatorScopeNamespace,
+					SourceFiles:   []SourceFile{{Children: sourceFiles[0].Children[:1]}},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "namespace-scoped operator with cluster-scoped resources",
+			workload: &StandaloneWorkload{
+				Spec: StandaloneWorkloadSpec{
+					OperatorScope: OperatorScopeNamespace,
+					SourceFiles:   sourceFiles,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "namespace-scoped operator with cluster-scoped custom resource",
+			workload: &StandaloneWorkload{
+				Spec: StandaloneWorkloadSpec{
+					API:           APISpec{Kind: "WebStore", ClusterScoped: true},
+					OperatorScope: OperatorScopeNamespace,
+				},
+			},
+			wantErr: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateOperatorScope(tt.workload)
+			if tt.wantErr {
+				assert.ErrorIs(t, err, ErrClusterScopedResources)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
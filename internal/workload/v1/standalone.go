@@ -132,6 +132,14 @@ func (s *StandaloneWorkload) IsClusterScoped() bool {
 	return s.Spec.API.ClusterScoped
 }
 
+func (s *StandaloneWorkload) IsNamespaceScoped() bool {
+	return s.Spec.OperatorScope == OperatorScopeNamespace
+}
+
+func (s *StandaloneWorkload) IsTracingEnabled() bool {
+	return s.Spec.Tracing
+}
+
 func (*StandaloneWorkload) IsStandalone() bool {
 	return true
 }
@@ -145,7 +153,7 @@ func (*StandaloneWorkload) IsCollection() bool {
 }
 
 func (s *StandaloneWorkload) SetResources(workloadPath string) error {
-	resources, err := processMarkers(workloadPath, s.Spec.Resources, false, false)
+	resources, err := processMarkers(workloadPath, s.Spec.Resources, false, false, nil)
 	if err != nil {
 		return err
 	}
@@ -153,6 +161,8 @@ func (s *StandaloneWorkload) SetResources(workloadPath string) error {
 	s.Spec.APISpecFields = resources.SpecFields
 	s.Spec.SourceFiles = *resources.SourceFiles
 	s.Spec.RBACRules = *resources.RBACRules
+	s.Spec.RBAC.addTo(&s.Spec.RBACRules)
+	rbacRulesCollapse(&s.Spec.RBACRules)
 	s.Spec.OwnershipRules = *resources.OwnershipRules
 
 	return nil
@@ -174,6 +184,22 @@ func (s *StandaloneWorkload) GetComponents() []*ComponentWorkload {
 	return []*ComponentWorkload{}
 }
 
+func (*StandaloneWorkload) GetCollection() *WorkloadCollection {
+	return nil
+}
+
+func (*StandaloneWorkload) GetCollections() []*WorkloadCollection {
+	return []*WorkloadCollection{}
+}
+
+func (*StandaloneWorkload) GetManagedComponents() []*ManagedComponent {
+	return []*ManagedComponent{}
+}
+
+func (*StandaloneWorkload) GetChildren() []WorkloadAPIBuilder {
+	return []WorkloadAPIBuilder{}
+}
+
 func (s *StandaloneWorkload) GetSourceFiles() *[]SourceFile {
 	return &s.Spec.SourceFiles
 }
@@ -182,6 +208,18 @@ func (s *StandaloneWorkload) GetFuncNames() (createFuncNames, initFuncNames []st
 	return getFuncNames(*s.GetSourceFiles())
 }
 
+func (s *StandaloneWorkload) GetReadyFuncNames() []string {
+	return getReadyFuncNames(*s.GetSourceFiles())
+}
+
+func (s *StandaloneWorkload) GetResourceWaves() []int {
+	return getResourceWaves(*s.GetSourceFiles())
+}
+
+func (s *StandaloneWorkload) GetResourceRecreatePolicies() []string {
+	return getResourceRecreatePolicies(*s.GetSourceFiles())
+}
+
 func (s *StandaloneWorkload) GetAPISpecFields() []*APISpecField {
 	return s.Spec.APISpecFields
 }
@@ -194,6 +232,10 @@ func (s *StandaloneWorkload) GetOwnershipRules() *[]OwnershipRule {
 	return &s.Spec.OwnershipRules
 }
 
+func (s *StandaloneWorkload) GetPersistence() *PersistenceConfig {
+	return &s.Spec.Persistence
+}
+
 func (*StandaloneWorkload) GetComponentResource(domain, repo string, clusterScoped bool) *resource.Resource {
 	return &resource.Resource{}
 }
@@ -39,9 +39,13 @@ type CliCommand struct {
 
 // StandaloneWorkloadSpec defines the attributes for a standalone workload.
 type StandaloneWorkloadSpec struct {
-	API                 APISpec    `json:"api" yaml:"api"`
-	CompanionCliRootcmd CliCommand `json:"companionCliRootcmd" yaml:"companionCliRootcmd" validate:"omitempty"`
-	Resources           []string   `json:"resources" yaml:"resources"`
+	API                 APISpec           `json:"api" yaml:"api"`
+	CompanionCliRootcmd CliCommand        `json:"companionCliRootcmd" yaml:"companionCliRootcmd" validate:"omitempty"`
+	Resources           []string          `json:"resources" yaml:"resources"`
+	RBAC                RBACConfig        `json:"rbac" yaml:"rbac"`
+	Persistence         PersistenceConfig `json:"persistence" yaml:"persistence"`
+	OperatorScope       OperatorScope     `json:"operatorScope" yaml:"operatorScope" validate:"omitempty,oneof=cluster namespace"`
+	Tracing             bool              `json:"tracing" yaml:"tracing"`
 	APISpecFields       []*APISpecField
 	SourceFiles         []SourceFile
 	RBACRules           []RBACRule
@@ -60,9 +64,11 @@ type ComponentWorkloadSpec struct {
 	API                   APISpec    `json:"api" yaml:"api"`
 	CompanionCliSubcmd    CliCommand `json:"companionCliSubcmd" yaml:"companionCliSubcmd" validate:"omitempty"`
 	Resources             []string   `json:"resources" yaml:"resources"`
+	RBAC                  RBACConfig `json:"rbac" yaml:"rbac"`
 	Dependencies          []string   `json:"dependencies" yaml:"dependencies"`
 	ConfigPath            string
 	ComponentDependencies []*ComponentWorkload
+	Collection            *WorkloadCollection
 	APISpecFields         []*APISpecField
 	SourceFiles           []SourceFile
 	RBACRules             []RBACRule
@@ -77,18 +83,36 @@ type ComponentWorkload struct {
 
 // WorkloadCollectionSpec defines the attributes for a workload collection.
 type WorkloadCollectionSpec struct {
-	API                 APISpec    `json:"api" yaml:"api"`
-	CompanionCliRootcmd CliCommand `json:"companionCliRootcmd" yaml:"companionCliRootcmd" validate:"omitempty"`
-	CompanionCliSubcmd  CliCommand `json:"companionCliSubcmd" yaml:"companionCliSubcmd" validate:"omitempty"`
-	Resources           []string   `json:"resources" yaml:"resources"`
-	ComponentFiles      []string   `json:"componentFiles" yaml:"componentFiles"`
+	API                 APISpec           `json:"api" yaml:"api"`
+	CompanionCliRootcmd CliCommand        `json:"companionCliRootcmd" yaml:"companionCliRootcmd" validate:"omitempty"`
+	CompanionCliSubcmd  CliCommand        `json:"companionCliSubcmd" yaml:"companionCliSubcmd" validate:"omitempty"`
+	Resources           []string          `json:"resources" yaml:"resources"`
+	RBAC                RBACConfig        `json:"rbac" yaml:"rbac"`
+	Persistence         PersistenceConfig `json:"persistence" yaml:"persistence"`
+	OperatorScope       OperatorScope     `json:"operatorScope" yaml:"operatorScope" validate:"omitempty,oneof=cluster namespace"`
+	Tracing             bool              `json:"tracing" yaml:"tracing"`
+	ComponentFiles      []string          `json:"componentFiles" yaml:"componentFiles"`
+	Defaults            WorkloadDefaults  `json:"defaults" yaml:"defaults"`
+	ManageComponents    bool              `json:"manageComponents" yaml:"manageComponents"`
+	ConfigPath          string
 	Components          []*ComponentWorkload
+	Collections         []*WorkloadCollection
+	Collection          *WorkloadCollection
 	APISpecFields       []*APISpecField
 	SourceFiles         []SourceFile
 	RBACRules           []RBACRule
 	OwnershipRules      []OwnershipRule
 }
 
+// WorkloadDefaults defines the API attributes that are inherited by the
+// components and nested collections of a workload collection unless they are
+// set on the component or nested collection.
+type WorkloadDefaults struct {
+	Domain  string `json:"domain" yaml:"domain"`
+	Group   string `json:"group" yaml:"group"`
+	Version string `json:"version" yaml:"version"`
+}
+
 // WorkloadCollection defines a workload collection.
 type WorkloadCollection struct {
 	WorkloadShared `yaml:",inline"`
@@ -125,6 +149,19 @@ type ChildResource struct {
 	Kind          string
 	StaticContent string
 	SourceCode    string
+
+	// ReadyExpression is the ready expression of the resource marker and
+	// ReadyChecks are the checks which it was compiled into.
+	ReadyExpression string
+	ReadyChecks     []ReadyCheck
+
+	// Wave is the wave in which the resource is created, either from the
+	// resource marker or the default wave of its kind.
+	Wave int
+
+	// RecreatePolicy is the recreate policy of the resource marker, which
+	// overrides the recreate policy of the kind of the resource.
+	RecreatePolicy RecreatePolicy
 }
 
 // SourceCodeTemplateData is a collection of variables used to generate source code.
@@ -144,6 +181,96 @@ type RBACRule struct {
 	VerbString string
 }
 
+// RBACConfig contains the rbac rules which are provided explicitly in the workload
+// config, in addition to the rules which are derived from the resource manifests.
+type RBACConfig struct {
+	// Rules are added to the rules which are derived from the resource manifests.
+	Rules []RBACRuleConfig `json:"rules" yaml:"rules" validate:"dive"`
+
+	// Restrictions limit the verbs which are granted for a group and resource to
+	// the listed verbs.  Rules which are left without verbs are removed.
+	Restrictions []RBACRuleConfig `json:"restrictions" yaml:"restrictions" validate:"dive"`
+}
+
+// RBACRuleConfig defines the verbs for one or more resources in an API group.
+type RBACRuleConfig struct {
+	Group     string   `json:"group" yaml:"group"`
+	Resources []string `json:"resources" yaml:"resources" validate:"required"`
+	Verbs     []string `json:"verbs" yaml:"verbs" validate:"required"`
+}
+
+// PersistenceStrategy defines how the child resources of a workload are
+// persisted to the cluster by the controller.
+type PersistenceStrategy string
+
+const (
+	PersistenceStrategyPatch PersistenceStrategy = "patch"
+	PersistenceStrategyApply PersistenceStrategy = "apply"
+)
+
+// ApplyConflicts defines how conflicts with other field managers are handled
+// when child resources are persisted with server-side apply.
+type ApplyConflicts string
+
+const (
+	ApplyConflictsForce  ApplyConflicts = "force"
+	ApplyConflictsReport ApplyConflicts = "report"
+)
+
+// RecreatePolicy defines whether a child resource is deleted and created again by
+// the controller when it cannot be updated because immutable fields are changed.
+type RecreatePolicy string
+
+const (
+	RecreatePolicyNever    RecreatePolicy = "never"
+	RecreatePolicyRecreate RecreatePolicy = "recreate"
+	RecreatePolicyOrphan   RecreatePolicy = "orphan"
+)
+
+// RecreateConfig defines the recreate policies of the child resources of a workload.
+type RecreateConfig struct {
+	// Policy is the recreate policy for child resources of kinds which are not
+	// listed in Kinds.  Defaults to never.
+	Policy RecreatePolicy `json:"policy" yaml:"policy" validate:"omitempty,oneof=never recreate orphan"`
+
+	// Kinds defines the recreate policy for child resources of specific kinds.
+	Kinds map[string]RecreatePolicy `json:"kinds" yaml:"kinds" validate:"dive,oneof=never recreate orphan"`
+}
+
+// PersistenceConfig defines how the child resources of a workload are persisted
+// to the cluster by the controller.
+type PersistenceConfig struct {
+	// Strategy is the persistence strategy for child resources of kinds which
+	// are not listed in Kinds.  Defaults to patch.
+	Strategy PersistenceStrategy `json:"strategy" yaml:"strategy" validate:"omitempty,oneof=patch apply"`
+
+	// Kinds defines the persistence strategy for child resources of specific kinds.
+	Kinds map[string]PersistenceStrategy `json:"kinds" yaml:"kinds" validate:"dive,oneof=patch apply"`
+
+	// Conflicts defines whether conflicts with other field managers are forced
+	// or reported when using server-side apply.  Defaults to force.
+	Conflicts ApplyConflicts `json:"conflicts" yaml:"conflicts" validate:"omitempty,oneof=force report"`
+
+	// Recreate defines whether child resources are deleted and created again
+	// when immutable fields are changed.
+	Recreate RecreateConfig `json:"recreate" yaml:"recreate"`
+
+	// RestartOnConfigChange defines whether the hash of the ConfigMaps and Secrets
+	// of a workload is stamped on the pod templates of the Deployments, StatefulSets
+	// and DaemonSets which reference them, so that they are restarted when the
+	// ConfigMaps and Secrets change.
+	RestartOnConfigChange bool `json:"restartOnConfigChange" yaml:"restartOnConfigChange"`
+}
+
+// OperatorScope defines which namespaces are watched by the operator which is
+// generated for a workload.
+type OperatorScope string
+
+const (
+	OperatorScopeCluster   OperatorScope = "cluster"
+	OperatorScopeNamespace OperatorScope = "namespace"
+)
+
 // OwnershipRule contains the info needed to create the controller ownership
 // functionality when setting up the controller with the manager.  This allows
 // the controller to reconcile the state of a deleted resource that it manages.
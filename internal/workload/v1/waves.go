@@ -0,0 +1,42 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+package v1
+
+// The default waves in which child resources are created, by kind.  Resources of a wave are
+// created once all resources of the previous waves are ready.
+const (
+	WaveNamespace = iota
+	WaveCustomResourceDefinition
+	WaveRBAC
+	WaveConfiguration
+	WaveWorkload
+)
+
+// defaultWave returns the default wave of a child resource of a kind.
+func defaultWave(kind string) int {
+	switch kind {
+	case "Namespace":
+		return WaveNamespace
+	case "CustomResourceDefinition":
+		return WaveCustomResourceDefinition
+	case "ServiceAccount", "Role", "RoleBinding", "ClusterRole", "ClusterRoleBinding":
+		return WaveRBAC
+	case "ConfigMap", "Secret":
+		return WaveConfiguration
+	}
+
+	return WaveWorkload
+}
+
+// getResourceWaves returns the waves of the child resources, in the same order as the
+// create functions.
+func getResourceWaves(sourceFiles []SourceFile) (waves []int) {
+	for _, sourceFile := range sourceFiles {
+		for _, childResource := range sourceFile.Children {
+			waves = append(waves, childResource.Wave)
+		}
+	}
+
+	return waves
+}
@@ -0,0 +1,69 @@
+// Copyright 2021 VMware, Inc.
+// SPDX-License-Identifier: MIT
+
+//nolint:testpackage
+package v1
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_defaultWave(t *testing.T) {
+	t.Parallel()
+
+	for _, tt := range []struct {
+		kind     string
+		expected int
+	}{
+		{kind: "Namespace", expected: WaveNamespace},
+		{kind: "CustomResourceDefinition", expected: WaveCustomResourceDefinition},
+		{kind: "ClusterRoleBinding", expected: WaveRBAC},
+		{kind: "Secret", expected: WaveConfiguration},
+		{kind: "Deployment", expected: WaveWorkload},
+		{kind: "WebStore", expected: WaveWorkload},
+	} {
+		tt := tt
+		t.Run(tt.kind, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, defaultWave(tt.kind))
+		})
+	}
+}
+
+func Test_processMarkersResourceWave(t *testing.T) {
+	t.Parallel()
+
+	manifests := `---
+# +operator-builder:resource:wave=1
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: database
+---
+# +operator-builder:resource:wave=5,ready=".status.readyReplicas >= 1"
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: app
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: config
+`
+
+	dir := t.TempDir()
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "app.yaml"), []byte(manifests), 0o600))
+
+	results, err := processMarkers(filepath.Join(dir, "workload.yaml"), []string{"app.yaml"}, false, false, nil)
+	require.NoError(t, err)
+
+	sourceFiles := *results.SourceFiles
+	assert.Equal(t, []int{1, 5, WaveConfiguration}, getResourceWaves(sourceFiles))
+	assert.Equal(t, []string{"", "DeploymentAppIsReady", ""}, getReadyFuncNames(sourceFiles))
+}